@@ -0,0 +1,70 @@
+package neo
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+// DiffAccountStates returns the net change of each asset's balance from before to after,
+// such as two states of an address returned by GetBalanceAtHeight, keyed by the 0x
+// prefixed, lowercase, asset ID returned by NormalizeAssetID. Changes are Fixed8 decimal
+// strings, negative for a loss. An asset held in only one of the states changes from or
+// to zero, and assets whose balance did not change are left out. An error is returned
+// when the states are of different addresses or hold an invalid asset ID.
+func DiffAccountStates(before, after *models.AccountState) (map[string]string, error) {
+	if before == nil || after == nil {
+		return nil, errors.New("both account states are required")
+	}
+
+	if before.ScriptHash != "" && after.ScriptHash != "" && !AssetEquals(before.ScriptHash, after.ScriptHash) {
+		return nil, fmt.Errorf(
+			"account states are of different addresses: '%s' and '%s'",
+			before.ScriptHash, after.ScriptHash,
+		)
+	}
+
+	changes := map[string]*big.Int{}
+	add := func(balances []models.AccountBalance, sign int64) error {
+		for _, balance := range balances {
+			asset, err := NormalizeAssetID(balance.Asset)
+			if err != nil {
+				return err
+			}
+
+			if changes[asset] == nil {
+				changes[asset] = new(big.Int)
+			}
+
+			value := new(big.Int).Mul(big.NewInt(int64(balance.Value)), big.NewInt(sign))
+			changes[asset].Add(changes[asset], value)
+		}
+
+		return nil
+	}
+
+	if err := add(before.Balances, -1); err != nil {
+		return nil, err
+	}
+
+	if err := add(after.Balances, 1); err != nil {
+		return nil, err
+	}
+
+	diff := map[string]string{}
+	for asset, change := range changes {
+		if change.Sign() == 0 {
+			continue
+		}
+
+		if !change.IsInt64() {
+			return nil, fmt.Errorf("change of %s is out of range: %s", asset, change)
+		}
+
+		diff[asset] = models.Fixed8(change.Int64()).String()
+	}
+
+	return diff, nil
+}
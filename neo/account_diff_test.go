@@ -0,0 +1,85 @@
+package neo_test
+
+import (
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountDiff(t *testing.T) {
+	neoAsset := "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b"
+	gasAsset := "0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7"
+	scriptHash := "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9"
+
+	state := func(balances ...models.AccountBalance) *models.AccountState {
+		return &models.AccountState{
+			ScriptHash: scriptHash,
+			Balances:   balances,
+		}
+	}
+
+	t.Run("DiffAccountStates()", func(t *testing.T) {
+		t.Run("GainsAndLosses", func(t *testing.T) {
+			before := state(
+				models.AccountBalance{Asset: neoAsset, Value: models.NewFixed8(10)},
+				models.AccountBalance{Asset: gasAsset, Value: models.Fixed8(150000000)},
+			)
+			after := state(
+				models.AccountBalance{Asset: neoAsset, Value: models.NewFixed8(4)},
+				models.AccountBalance{Asset: gasAsset, Value: models.Fixed8(250000001)},
+			)
+
+			diff, err := neo.DiffAccountStates(before, after)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{
+				neoAsset: "-6",
+				gasAsset: "1.00000001",
+			}, diff)
+		})
+
+		t.Run("NewAndRemovedAssets", func(t *testing.T) {
+			before := state(
+				models.AccountBalance{Asset: neoAsset, Value: models.NewFixed8(10)},
+			)
+			after := state(
+				models.AccountBalance{Asset: gasAsset[2:], Value: models.Fixed8(50000000)},
+			)
+
+			diff, err := neo.DiffAccountStates(before, after)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{
+				neoAsset: "-10",
+				gasAsset: "0.5",
+			}, diff)
+		})
+
+		t.Run("Unchanged", func(t *testing.T) {
+			before := state(
+				models.AccountBalance{Asset: neoAsset, Value: models.NewFixed8(10)},
+			)
+			after := state(
+				models.AccountBalance{Asset: "0xC56F33FC6ECFCD0C225C4AB356FEE59390AF8560BE0E930FAEBE74A6DAFF7C9B", Value: models.NewFixed8(10)},
+			)
+
+			diff, err := neo.DiffAccountStates(before, after)
+			assert.NoError(t, err)
+			assert.Empty(t, diff)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			_, err := neo.DiffAccountStates(nil, state())
+			assert.Error(t, err)
+
+			other := state()
+			other.ScriptHash = "0x0000000000000000000000000000000000000001"
+
+			_, err = neo.DiffAccountStates(state(), other)
+			assert.Error(t, err)
+
+			_, err = neo.DiffAccountStates(state(), state(models.AccountBalance{Asset: "0x01", Value: 1}))
+			assert.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,142 @@
+package neo
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lomocoin/neo-go-sdk/utility"
+	"golang.org/x/crypto/ripemd160"
+)
+
+// DefaultAddressVersion is the version byte of addresses on the NEO2 MainNet and TestNet.
+// Private networks may use a different version byte, which is passed to the address
+// functions instead.
+const DefaultAddressVersion byte = 0x17
+
+// ScriptHashToAddress encodes the script hash, in big-endian hex with or without a 0x
+// prefix as returned by the node, as an address with the given version byte.
+func ScriptHashToAddress(scriptHash string, version byte) (string, error) {
+	scriptHashBytes, err := hex.DecodeString(strings.TrimPrefix(scriptHash, "0x"))
+	if err != nil {
+		return "", fmt.Errorf("Script hash is not valid hex: %s", err)
+	}
+
+	if len(scriptHashBytes) != 20 {
+		return "", fmt.Errorf(
+			"Expected length of script hash to be 20, got: %d", len(scriptHashBytes),
+		)
+	}
+
+	return encodeAddress(version, reverseBytes(scriptHashBytes)), nil
+}
+
+// AddressToScriptHash decodes the address and returns its script hash in 0x prefixed,
+// big-endian, hex. An error is returned when the address does not have the given version
+// byte.
+func AddressToScriptHash(address string, version byte) (string, error) {
+	addressVersion, scriptHash, err := decodeAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	if addressVersion != version {
+		return "", fmt.Errorf(
+			"Expected address version to be '0x%02x', got: 0x%02x", version, addressVersion,
+		)
+	}
+
+	return "0x" + hex.EncodeToString(reverseBytes(scriptHash)), nil
+}
+
+// PublicKeyToAddress returns the address, with the given version byte, of the hex encoded
+// public key. The public key may be compressed or uncompressed, the verification script
+// which is hashed is that of the network generation.
+func PublicKeyToAddress(publicKey string, generation NetworkGeneration, version byte) (string, error) {
+	key, err := parsePublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	compressed := elliptic.MarshalCompressed(key.Curve, key.X, key.Y)
+	scriptHash := hash160(generation.verificationScript(compressed))
+
+	return encodeAddress(version, scriptHash), nil
+}
+
+// ContractAddress returns the address of the account of a deployed contract, so that its
+// holdings can be looked up, using the version byte returned by AddressVersion. The
+// script hash may be given in either of the conventions used by NEO: 0x prefixed and
+// big-endian, as returned by the node, or unprefixed and little-endian, as serialized in
+// scripts and transaction attributes.
+func (c Client) ContractAddress(scriptHash string) (string, error) {
+	version, err := c.AddressVersion()
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.HasPrefix(scriptHash, "0x") {
+		scriptHashBytes, err := hex.DecodeString(scriptHash)
+		if err != nil {
+			return "", fmt.Errorf("Script hash is not valid hex: %s", err)
+		}
+
+		scriptHash = hex.EncodeToString(reverseBytes(scriptHashBytes))
+	}
+
+	return ScriptHashToAddress(scriptHash, version)
+}
+
+// encodeAddress encodes the script hash as a NEO address: the version byte and the script
+// hash, followed by the first 4 bytes of their double SHA-256 as a checksum, in base58.
+func encodeAddress(version byte, scriptHash []byte) string {
+	payload := append([]byte{version}, scriptHash...)
+
+	firstSHA := sha256.Sum256(payload)
+	secondSHA := sha256.Sum256(firstSHA[:])
+
+	payload = append(payload, secondSHA[:4]...)
+
+	base58 := utility.NewBase58()
+	return base58.Encode(payload)
+}
+
+// decodeAddress decodes a NEO address, verifying its checksum, and returns the version
+// byte and script hash.
+func decodeAddress(address string) (byte, []byte, error) {
+	base58 := utility.NewBase58()
+
+	decoded, err := base58.Decode(address)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(decoded) != 25 {
+		return 0, nil, fmt.Errorf(
+			"Expected length of decoded address to be 25, got: %d", len(decoded),
+		)
+	}
+
+	firstSHA := sha256.Sum256(decoded[:21])
+	secondSHA := sha256.Sum256(firstSHA[:])
+
+	if !bytes.Equal(secondSHA[:4], decoded[21:]) {
+		return 0, nil, fmt.Errorf("Address failed checksum validation")
+	}
+
+	return decoded[0], decoded[1:21], nil
+}
+
+// hash160 returns the RIPEMD-160 of the SHA-256 of the script, which is the script hash
+// used in addresses.
+func hash160(script []byte) []byte {
+	sha := sha256.Sum256(script)
+
+	ripemd160H := ripemd160.New()
+	ripemd160H.Write(sha[:])
+
+	return ripemd160H.Sum(nil)
+}
@@ -0,0 +1,135 @@
+package neo_test
+
+import (
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddress(t *testing.T) {
+	scriptHash := "0xcef0c0fdcfe7838eff6ff104f9cdec2922297537"
+
+	// version byte of a private network, whose addresses start with "T"
+	customVersion := byte(0x42)
+	customAddress := "TeN3WBg5hfa3GQJ6tnQx8hScvvsx6EDGPA"
+
+	t.Run("ScriptHashToAddress()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			address, err := neo.ScriptHashToAddress(scriptHash, neo.DefaultAddressVersion)
+			assert.NoError(t, err)
+			assert.Equal(t, testAccounts[0].publicAddress, address)
+
+			address, err = neo.ScriptHashToAddress(scriptHash[2:], neo.DefaultAddressVersion)
+			assert.NoError(t, err)
+			assert.Equal(t, testAccounts[0].publicAddress, address)
+		})
+
+		t.Run("CustomVersion", func(t *testing.T) {
+			address, err := neo.ScriptHashToAddress(scriptHash, customVersion)
+			assert.NoError(t, err)
+			assert.Equal(t, customAddress, address)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			_, err := neo.ScriptHashToAddress("0xzz", neo.DefaultAddressVersion)
+			assert.Error(t, err)
+
+			_, err = neo.ScriptHashToAddress(scriptHash[:40], neo.DefaultAddressVersion)
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("AddressToScriptHash()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			hash, err := neo.AddressToScriptHash(testAccounts[0].publicAddress, neo.DefaultAddressVersion)
+			assert.NoError(t, err)
+			assert.Equal(t, scriptHash, hash)
+		})
+
+		t.Run("CustomVersion", func(t *testing.T) {
+			hash, err := neo.AddressToScriptHash(customAddress, customVersion)
+			assert.NoError(t, err)
+			assert.Equal(t, scriptHash, hash)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			_, err := neo.AddressToScriptHash(customAddress, neo.DefaultAddressVersion)
+			assert.Error(t, err)
+
+			_, err = neo.AddressToScriptHash(testAccounts[0].publicAddress[:33]+"X", neo.DefaultAddressVersion)
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("PublicKeyToAddress()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			for _, account := range testAccounts {
+				address, err := neo.PublicKeyToAddress(account.publicKey, neo.NEO2, neo.DefaultAddressVersion)
+				assert.NoError(t, err)
+				assert.Equal(t, account.publicAddress, address)
+			}
+
+			address, err := neo.PublicKeyToAddress(testAccounts[0].publicKey, neo.NEO3, 0x35)
+			assert.NoError(t, err)
+			assert.Equal(t, "NPTmAHDxo6Pkyic8Nvu3kwyXoYJCvcCB6i", address)
+		})
+
+		t.Run("UncompressedPublicKey", func(t *testing.T) {
+			publicKey := "04028a99826edc0c97d18e22b6932373d908d323aa7f92656a77ec26e8861699ef" +
+				"35787439bef71cafeebeb4dfd8954d13470d3c383d59f491d98b079c5edbcc2e"
+
+			address, err := neo.PublicKeyToAddress(publicKey, neo.NEO2, neo.DefaultAddressVersion)
+			assert.NoError(t, err)
+			assert.Equal(t, testAccounts[0].publicAddress, address)
+		})
+
+		t.Run("CustomVersion", func(t *testing.T) {
+			address, err := neo.PublicKeyToAddress(testAccounts[0].publicKey, neo.NEO2, customVersion)
+			assert.NoError(t, err)
+			assert.Equal(t, customAddress, address)
+
+			address, err = neo.PublicKeyToAddress(testAccounts[0].publicKey, neo.NEO3, customVersion)
+			assert.NoError(t, err)
+			assert.Equal(t, "Tcrbxh6i2SR9cMRFhPEC4aWkz6eUA9mvyD", address)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			_, err := neo.PublicKeyToAddress(testAccounts[0].publicKey[:64], neo.NEO2, neo.DefaultAddressVersion)
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run(".ContractAddress()", func(t *testing.T) {
+		// NeoToken native contract on the NEO3 MainNet
+		contractAddress := "NiHURyS83nX2mpxtA7xq84cGxVbHojj5Wc"
+
+		client := neo.NewClient("http://localhost:10332", neo.WithNetworkGeneration(neo.NEO3))
+
+		t.Run("BigEndian", func(t *testing.T) {
+			address, err := client.ContractAddress("0xef4073a0f2b305a38ec4050e4d3d28bc40ea63f5")
+			assert.NoError(t, err)
+			assert.Equal(t, contractAddress, address)
+		})
+
+		t.Run("LittleEndian", func(t *testing.T) {
+			address, err := client.ContractAddress("f563ea40bc283d4d0e05c48ea305b3f2a07340ef")
+			assert.NoError(t, err)
+			assert.Equal(t, contractAddress, address)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			_, err := client.ContractAddress("f563ea40bc283d4d0e05c48ea305b3f2a07340")
+			assert.Error(t, err)
+
+			_, err = client.ContractAddress("zz63ea40bc283d4d0e05c48ea305b3f2a07340ef")
+			assert.Error(t, err)
+
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			_, err = neo.NewClient(node.URL).ContractAddress("0xef4073a0f2b305a38ec4050e4d3d28bc40ea63f5")
+			assert.Error(t, err)
+		})
+	})
+}
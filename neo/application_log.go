@@ -0,0 +1,70 @@
+package neo
+
+import (
+	"encoding/json"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/lomocoin/neo-go-sdk/neo/models/response"
+)
+
+// GetApplicationLog returns the application log of the transaction, which holds the
+// outcome of each execution of its scripts, including the notifications (such as token
+// transfers) raised by contracts. The node must have the ApplicationLogs plugin
+// installed. The Encoding of the notification states is set from the node's network
+// generation, in the same way as ParseExecutionStack.
+func (c Client) GetApplicationLog(txHash string) (*models.ApplicationLog, error) {
+	requestBodyParams := []interface{}{
+		txHash,
+	}
+	var resp response.ApplicationLog
+
+	err := c.executeRequest("getapplicationlog", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.Result.Executions {
+		notifications := resp.Result.Executions[i].Notifications
+		if len(notifications) == 0 {
+			continue
+		}
+
+		encoding := c.stackEncoding()
+		for j := range notifications {
+			notifications[j].State.Encoding = encoding
+		}
+	}
+
+	return &resp.Result, nil
+}
+
+// ParseExecutionStack decodes the stack left by an execution into stack items, whose
+// values can be read with their As* methods. The Encoding of the stack items is set from
+// the node's network generation when it is known, as NEO2 nodes encode byte arrays in
+// hex and NEO3 nodes in base64.
+func (c Client) ParseExecutionStack(execution models.Execution) ([]models.StackItem, error) {
+	encoding := c.stackEncoding()
+	stack := make([]models.StackItem, 0, len(execution.Stack))
+
+	for _, rawItem := range execution.Stack {
+		var item models.StackItem
+		if err := json.Unmarshal(rawItem, &item); err != nil {
+			return nil, err
+		}
+
+		item.Encoding = encoding
+		stack = append(stack, item)
+	}
+
+	return stack, nil
+}
+
+// stackEncoding returns the encoding of byte array stack items returned by the node,
+// which is hex unless the node is known to be a NEO3 node.
+func (c Client) stackEncoding() models.ByteEncoding {
+	if generation, err := c.NetworkGeneration(); err == nil {
+		return generation.StackEncoding()
+	}
+
+	return models.ByteEncodingHex
+}
@@ -0,0 +1,233 @@
+package neo_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicationLog(t *testing.T) {
+	neo2ApplicationLog := `{
+		"txid": "0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea",
+		"executions": [
+			{
+				"trigger": "Application",
+				"contract": "0x2e25d2127e0240c6deaf35394702feb236d4d7fc",
+				"vmstate": "HALT, BREAK",
+				"gas_consumed": "2.855",
+				"stack": [
+					{"type": "ByteArray", "value": "68656c6c6f"},
+					{"type": "Integer", "value": "42"},
+					{"type": "Boolean", "value": true},
+					{"type": "Array", "value": [{"type": "ByteArray", "value": "2a"}]}
+				],
+				"notifications": []
+			}
+		]
+	}`
+
+	neo3ApplicationLog := `{
+		"txid": "0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea",
+		"executions": [
+			{
+				"trigger": "Application",
+				"vmstate": "HALT",
+				"exception": null,
+				"gasconsumed": "9999540",
+				"stack": [
+					{"type": "ByteString", "value": "aGVsbG8="},
+					{"type": "Integer", "value": "42"},
+					{"type": "Boolean", "value": true},
+					{"type": "Array", "value": [{"type": "ByteArray", "value": "Kg=="}]}
+				],
+				"notifications": []
+			}
+		]
+	}`
+
+	t.Run(".GetApplicationLog()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getapplicationlog": testRawResult(neo2ApplicationLog),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			applicationLog, err := client.GetApplicationLog("0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea")
+			assert.NoError(t, err)
+			assert.Len(t, applicationLog.Executions, 1)
+
+			execution := applicationLog.Executions[0]
+			assert.Equal(t, "Application", execution.Trigger)
+			assert.Equal(t, "0x2e25d2127e0240c6deaf35394702feb236d4d7fc", execution.Contract)
+			assert.Equal(t, "HALT, BREAK", execution.VMState)
+			assert.Len(t, execution.Stack, 4)
+		})
+
+		t.Run("GasConsumed", func(t *testing.T) {
+			for generation, applicationLog := range map[neo.NetworkGeneration]string{
+				neo.NEO2: neo2ApplicationLog,
+				neo.NEO3: neo3ApplicationLog,
+			} {
+				node := newTestNode(map[string]testHandler{
+					"getapplicationlog": testRawResult(applicationLog),
+				})
+				defer node.Close()
+
+				client := neo.NewClient(node.URL, neo.WithNetworkGeneration(generation))
+
+				log, err := client.GetApplicationLog("0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea")
+				assert.NoError(t, err)
+				assert.Equal(t, map[neo.NetworkGeneration]string{
+					neo.NEO2: "2.855",
+					neo.NEO3: "0.0999954",
+				}[generation], log.Executions[0].GasConsumed)
+			}
+		})
+
+		t.Run("Notifications", func(t *testing.T) {
+			for generation, applicationLog := range map[neo.NetworkGeneration]string{
+				neo.NEO2: `{
+					"txid": "0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea",
+					"executions": [{
+						"trigger": "Application",
+						"vmstate": "HALT",
+						"stack": [],
+						"notifications": [{
+							"contract": "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9",
+							"state": {
+								"type": "Array",
+								"value": [
+									{"type": "ByteArray", "value": "7472616e73666572"},
+									{"type": "ByteArray", "value": "01"},
+									{"type": "ByteArray", "value": "02"},
+									{"type": "ByteArray", "value": "00e1f505"}
+								]
+							}
+						}]
+					}]
+				}`,
+				neo.NEO3: `{
+					"txid": "0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea",
+					"executions": [{
+						"trigger": "Application",
+						"vmstate": "HALT",
+						"stack": [],
+						"notifications": [{
+							"contract": "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9",
+							"eventname": "transfer",
+							"state": {
+								"type": "Array",
+								"value": [
+									{"type": "ByteArray", "value": "dHJhbnNmZXI="},
+									{"type": "ByteString", "value": "AQ=="},
+									{"type": "ByteString", "value": "Ag=="},
+									{"type": "Integer", "value": "100000000"}
+								]
+							}
+						}]
+					}]
+				}`,
+			} {
+				t.Run(generation.String(), func(t *testing.T) {
+					node := newTestNode(map[string]testHandler{
+						"getapplicationlog": testRawResult(applicationLog),
+					})
+					defer node.Close()
+
+					client := neo.NewClient(node.URL, neo.WithNetworkGeneration(generation))
+
+					log, err := client.GetApplicationLog("0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea")
+					assert.NoError(t, err)
+					assert.Len(t, log.Executions[0].Notifications, 1)
+
+					notification := log.Executions[0].Notifications[0]
+					assert.Equal(t, "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9", notification.Contract)
+
+					items, err := notification.State.AsArray()
+					assert.NoError(t, err)
+					assert.Len(t, items, 4)
+
+					event, err := items[0].AsString()
+					assert.NoError(t, err)
+					assert.Equal(t, "transfer", event)
+
+					from, err := items[1].AsByteArray()
+					assert.NoError(t, err)
+					assert.Equal(t, []byte{0x01}, from)
+
+					amount, err := items[3].AsInteger()
+					assert.NoError(t, err)
+					assert.Equal(t, big.NewInt(100000000), amount)
+				})
+			}
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetApplicationLog("0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea")
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run(".ParseExecutionStack()", func(t *testing.T) {
+		for generation, applicationLog := range map[neo.NetworkGeneration]string{
+			neo.NEO2: neo2ApplicationLog,
+			neo.NEO3: neo3ApplicationLog,
+		} {
+			t.Run(generation.String(), func(t *testing.T) {
+				node := newTestNode(map[string]testHandler{
+					"getapplicationlog": testRawResult(applicationLog),
+				})
+				defer node.Close()
+
+				client := neo.NewClient(node.URL, neo.WithNetworkGeneration(generation))
+
+				log, err := client.GetApplicationLog("0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea")
+				assert.NoError(t, err)
+
+				stack, err := client.ParseExecutionStack(log.Executions[0])
+				assert.NoError(t, err)
+				assert.Len(t, stack, 4)
+
+				value, err := stack[0].AsString()
+				assert.NoError(t, err)
+				assert.Equal(t, "hello", value)
+
+				integer, err := stack[1].AsInteger()
+				assert.NoError(t, err)
+				assert.Equal(t, big.NewInt(42), integer)
+
+				boolean, err := stack[2].AsBool()
+				assert.NoError(t, err)
+				assert.True(t, boolean)
+
+				items, err := stack[3].AsArray()
+				assert.NoError(t, err)
+				assert.Len(t, items, 1)
+
+				integer, err = items[0].AsInteger()
+				assert.NoError(t, err)
+				assert.Equal(t, big.NewInt(42), integer)
+			})
+		}
+
+		t.Run("SadCase", func(t *testing.T) {
+			client := neo.NewClient("http://localhost:10332", neo.WithNetworkGeneration(neo.NEO2))
+
+			_, err := client.ParseExecutionStack(models.Execution{
+				Stack: []json.RawMessage{json.RawMessage(`"not a stack item"`)},
+			})
+			assert.Error(t, err)
+		})
+	})
+}
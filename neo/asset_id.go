@@ -0,0 +1,61 @@
+package neo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The IDs of the native NEO2 global assets, in 0x prefixed big-endian hex.
+const (
+	NEOAssetID = "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b"
+	GASAssetID = "0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7"
+)
+
+// nativeAssetIDs maps the symbols of the native assets, in upper case, to their IDs.
+var nativeAssetIDs = map[string]string{
+	"NEO": NEOAssetID,
+	"GAS": GASAssetID,
+}
+
+// NormalizeAssetID returns the asset ID as 0x prefixed lower case hex, whatever its case
+// and whether or not it has the 0x prefix. The ID may be the 32 byte ID of a NEO2 global
+// asset or the 20 byte script hash of a token contract. The symbols "NEO" and "GAS", in
+// any case, are resolved to NEOAssetID and GASAssetID, the IDs of the NEO2 native assets.
+// An error is returned when the ID is neither.
+func NormalizeAssetID(id string) (string, error) {
+	id = strings.TrimSpace(id)
+
+	if assetID, ok := nativeAssetIDs[strings.ToUpper(id)]; ok {
+		return assetID, nil
+	}
+
+	hexID := strings.ToLower(id)
+	hexID = strings.TrimPrefix(hexID, "0x")
+
+	if len(hexID) != 64 && len(hexID) != 40 {
+		return "", fmt.Errorf("asset ID must be 32 or 20 bytes of hex, got: '%s'", id)
+	}
+
+	if strings.Trim(hexID, "0123456789abcdef") != "" {
+		return "", fmt.Errorf("asset ID is not hex, got: '%s'", id)
+	}
+
+	return "0x" + hexID, nil
+}
+
+// AssetEquals reports whether the asset IDs identify the same asset, comparing them once
+// normalized by NormalizeAssetID, so that "NEO", NEOAssetID and NEOAssetID without the 0x
+// prefix are all equal. IDs which cannot be normalized are not equal to any asset.
+func AssetEquals(a, b string) bool {
+	normalizedA, err := NormalizeAssetID(a)
+	if err != nil {
+		return false
+	}
+
+	normalizedB, err := NormalizeAssetID(b)
+	if err != nil {
+		return false
+	}
+
+	return normalizedA == normalizedB
+}
@@ -0,0 +1,64 @@
+package neo_test
+
+import (
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssetID(t *testing.T) {
+	t.Run("NormalizeAssetID()", func(t *testing.T) {
+		testCases := []struct {
+			id       string
+			expected string
+			err      bool
+		}{
+			{id: "NEO", expected: neo.NEOAssetID},
+			{id: "gas", expected: neo.GASAssetID},
+			{id: " Neo ", expected: neo.NEOAssetID},
+			{id: "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b", expected: neo.NEOAssetID},
+			{id: "c56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b", expected: neo.NEOAssetID},
+			{id: "0X602C79718B16E442DE58778E148D0B1084E3B2DFFD5DE6B7B16CEE7969282DE7", expected: neo.GASAssetID},
+			{id: "EF4073A0F2B305A38EC4050E4D3D28BC40EA63F5", expected: "0xef4073a0f2b305a38ec4050e4d3d28bc40ea63f5"},
+			{id: "", err: true},
+			{id: "NEOX", err: true},
+			{id: "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c", err: true},
+			{id: "0xz56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b", err: true},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.id, func(t *testing.T) {
+				id, err := neo.NormalizeAssetID(testCase.id)
+				if testCase.err {
+					assert.Error(t, err)
+					return
+				}
+
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.expected, id)
+			})
+		}
+	})
+
+	t.Run("AssetEquals()", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			a, b        string
+			equal       bool
+		}{
+			{description: "SymbolAndID", a: "NEO", b: neo.NEOAssetID, equal: true},
+			{description: "SymbolAndUnprefixedID", a: "gas", b: "602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7", equal: true},
+			{description: "MixedCase", a: "0xC56F33FC6ECFCD0C225C4AB356FEE59390AF8560BE0E930FAEBE74A6DAFF7C9B", b: "c56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b", equal: true},
+			{description: "DifferentAssets", a: "NEO", b: neo.GASAssetID},
+			{description: "Invalid", a: "NEOX", b: "NEOX"},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.description, func(t *testing.T) {
+				assert.Equal(t, testCase.equal, neo.AssetEquals(testCase.a, testCase.b))
+				assert.Equal(t, testCase.equal, neo.AssetEquals(testCase.b, testCase.a))
+			})
+		}
+	})
+}
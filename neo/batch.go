@@ -0,0 +1,260 @@
+package neo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+type (
+	// BatchError is returned by methods which carry out many independent calls, such as
+	// one call per address. It holds the error for each key (address, node URI, etc.) that
+	// failed, the results for the other keys are still returned alongside it.
+	BatchError map[string]error
+)
+
+// Error implements the error interface.
+func (b BatchError) Error() string {
+	keys := make([]string, 0, len(b))
+	for key := range b {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	messages := make([]string, 0, len(keys))
+	for _, key := range keys {
+		messages = append(messages, fmt.Sprintf("%s: %s", key, b[key]))
+	}
+
+	return fmt.Sprintf("%d of the calls failed: %s", len(b), strings.Join(messages, "; "))
+}
+
+// GetAccountStates fetches the account state of each address, sending the calls as
+// JSON-RPC batches of at most the maximum batch size (see WithMaxBatchSize), with at most
+// concurrency batches in flight at a time. The states are returned keyed by address. If
+// any of the calls fail a BatchError is returned, keyed by address, along with the states
+// that were fetched. When ctx is done no further batches are sent, those in flight are
+// aborted, and ctx.Err() is returned.
+func (c Client) GetAccountStates(ctx context.Context, addresses []string, concurrency int) (map[string]*models.AccountState, error) {
+	c = c.WithContext(ctx)
+
+	states := map[string]*models.AccountState{}
+	batchErr := BatchError{}
+
+	var keys []string
+	var calls []batchCall
+
+	for _, address := range uniqueStrings(addresses) {
+		if _, _, err := decodeAddress(address); err != nil {
+			batchErr[address] = err
+			continue
+		}
+
+		keys = append(keys, address)
+		calls = append(calls, batchCall{
+			method: "getaccountstate",
+			params: []interface{}{address},
+			result: &models.AccountState{},
+		})
+	}
+
+	for i, err := range c.executeBatch(ctx, calls, concurrency) {
+		if err == errCallNotSent {
+			continue
+		}
+
+		if err != nil {
+			batchErr[keys[i]] = err
+			continue
+		}
+
+		states[keys[i]] = calls[i].result.(*models.AccountState)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return states, err
+	}
+
+	if len(batchErr) > 0 {
+		return states, batchErr
+	}
+
+	return states, nil
+}
+
+// GetUnspentsMulti fetches the unspent outputs of each address, like GetUnspents, sending
+// the calls as JSON-RPC batches of at most the maximum batch size (see WithMaxBatchSize),
+// with at most concurrency batches in flight at a time. The unspents are returned keyed by
+// address. If any of the calls fail a BatchError is returned, keyed by address, along with
+// the unspents that were fetched. When ctx is done no further batches are sent, those in
+// flight are aborted, and ctx.Err() is returned.
+func (c Client) GetUnspentsMulti(ctx context.Context, addresses []string, concurrency int) (map[string]*models.Unspents, error) {
+	c = c.WithContext(ctx)
+
+	keys := uniqueStrings(addresses)
+	calls := make([]batchCall, len(keys))
+	for i, address := range keys {
+		calls[i] = batchCall{
+			method: "getunspents",
+			params: []interface{}{address},
+			result: &models.Unspents{},
+		}
+	}
+
+	unspents := map[string]*models.Unspents{}
+	batchErr := BatchError{}
+
+	for i, err := range c.executeBatch(ctx, calls, concurrency) {
+		if err == errCallNotSent {
+			continue
+		}
+
+		if err != nil {
+			batchErr[keys[i]] = err
+			continue
+		}
+
+		addressUnspents := calls[i].result.(*models.Unspents)
+		setUnspentsScriptHash(addressUnspents)
+
+		unspents[keys[i]] = addressUnspents
+	}
+
+	if err := ctx.Err(); err != nil {
+		return unspents, err
+	}
+
+	if len(batchErr) > 0 {
+		return unspents, batchErr
+	}
+
+	return unspents, nil
+}
+
+// AggregatedMempool fetches the unconfirmed transactions of each of the Client's nodes
+// concurrently, and returns the union of their transaction hashes. Nodes see different
+// pending transactions, so the union is more complete than the view of any one node. The
+// hashes are returned in node order, each only once. If any of the nodes fail a
+// BatchError is returned, keyed by node URI, along with the hashes of the other nodes.
+// When ctx is done no further calls are started, those in flight are aborted, and
+// ctx.Err() is returned.
+func (c Client) AggregatedMempool(ctx context.Context) ([]string, error) {
+	c = c.WithContext(ctx)
+
+	var mutex sync.Mutex
+	mempools := map[string][]string{}
+
+	batchErr := runConcurrently(ctx, c.nodeURIs, len(c.nodeURIs), func(nodeURI string) error {
+		tempClient := c.forNode(nodeURI)
+
+		hashes, err := tempClient.GetUnconfirmedTransactions()
+		if err != nil {
+			return err
+		}
+
+		mutex.Lock()
+		mempools[nodeURI] = hashes
+		mutex.Unlock()
+
+		return nil
+	})
+
+	seen := map[string]bool{}
+	hashes := []string{}
+
+	for _, nodeURI := range c.nodeURIs {
+		for _, hash := range mempools[nodeURI] {
+			if !seen[hash] {
+				seen[hash] = true
+				hashes = append(hashes, hash)
+			}
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return hashes, err
+	}
+
+	if batchErr != nil {
+		return hashes, batchErr
+	}
+
+	return hashes, nil
+}
+
+// runConcurrently calls fn once for each unique key, using at most concurrency
+// goroutines. Once ctx is done no further keys are handed out. The errors returned by fn
+// are collected into a BatchError, nil is returned when there are none.
+func runConcurrently(ctx context.Context, keys []string, concurrency int, fn func(key string) error) BatchError {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	batchErr := BatchError{}
+	queue := make(chan string)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for key := range queue {
+				if err := fn(key); err != nil {
+					mutex.Lock()
+					batchErr[key] = err
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+
+	seen := map[string]bool{}
+
+queueLoop:
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case queue <- key:
+		case <-ctx.Done():
+			break queueLoop
+		}
+	}
+
+	close(queue)
+	wg.Wait()
+
+	if len(batchErr) == 0 {
+		return nil
+	}
+
+	return batchErr
+}
+
+// uniqueStrings returns the values in order, each only once.
+func uniqueStrings(values []string) []string {
+	seen := map[string]bool{}
+	unique := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+
+	return unique
+}
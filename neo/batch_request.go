@@ -0,0 +1,178 @@
+package neo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models/request"
+	resp "github.com/lomocoin/neo-go-sdk/neo/models/response"
+)
+
+type (
+	// batchCall is a single call of a JSON-RPC batch, whose result is decoded into result.
+	batchCall struct {
+		method string
+		params []interface{}
+		result interface{}
+	}
+
+	// batchResponse is a single response of a JSON-RPC batch.
+	batchResponse struct {
+		ID     int64           `json:"id"`
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+)
+
+// DefaultMaxBatchSize is the number of calls sent in each JSON-RPC batch, unless
+// WithMaxBatchSize is used. It keeps the body of each request well under the size limits
+// of nodes and the proxies in front of them.
+const DefaultMaxBatchSize = 100
+
+// errCallNotSent is returned for the calls of a batch which were not sent because the
+// context was done first.
+var errCallNotSent = errors.New("call was not sent")
+
+// executeBatch sends the calls as JSON-RPC batches of at most the maximum batch size, with
+// at most concurrency batches in flight at a time, and decodes the result of each call.
+// The error of each call is returned in the order of the calls, nil when it succeeded.
+// When ctx is done no further batches are sent, their calls fail with errCallNotSent.
+func (c Client) executeBatch(ctx context.Context, calls []batchCall, concurrency int) []error {
+	errs := make([]error, len(calls))
+	for i := range errs {
+		errs[i] = errCallNotSent
+	}
+
+	size := c.maxBatchSize
+	if size < 1 {
+		size = DefaultMaxBatchSize
+	}
+
+	batches := int64((len(calls) + size - 1) / size)
+
+	_ = forEachIndex(ctx, 0, batches-1, concurrency, func(index int64) error {
+		start := int(index) * size
+		end := start + size
+		if end > len(calls) {
+			end = len(calls)
+		}
+
+		copy(errs[start:end], c.executeBatchRequest(calls[start:end]))
+		return nil
+	})
+
+	return errs
+}
+
+// executeBatchRequest sends the calls as a single JSON-RPC batch, with the longest timeout
+// of their methods. When the batch as a whole fails, every call fails with its error.
+func (c Client) executeBatchRequest(calls []batchCall) []error {
+	errs := make([]error, len(calls))
+
+	methods := make([]string, len(calls))
+	for i, call := range calls {
+		methods[i] = call.method
+	}
+
+	err := c.forMethods(methods...).withTimeout(c.timeoutFor(methods...), func(c Client) error {
+		return c.doBatchRequest(calls, errs)
+	})
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+	}
+
+	return errs
+}
+
+func (c Client) doBatchRequest(calls []batchCall, errs []error) error {
+	methods := make([]string, len(calls))
+	parameters := make([][]interface{}, len(calls))
+	for i, call := range calls {
+		methods[i] = call.method
+		parameters[i] = call.params
+		if parameters[i] == nil {
+			parameters[i] = []interface{}{}
+		}
+	}
+
+	body := getBuffer()
+	defer putBuffer(body)
+
+	err := request.EncodeBatchBody(body, methods, parameters)
+	if err != nil {
+		return err
+	}
+
+	response, err := c.sendRequest(body.Bytes())
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != 200 {
+		return fmt.Errorf(
+			"non-200 status code returned from NEO node, got: '%d'",
+			response.StatusCode,
+		)
+	}
+
+	responseBody := getBuffer()
+	defer putBuffer(responseBody)
+
+	_, err = responseBody.ReadFrom(response.Body)
+	if err != nil {
+		return err
+	}
+	responseBytes := bytes.TrimSpace(responseBody.Bytes())
+
+	// a node which rejects the batch as a whole responds with a single error object
+	if !bytes.HasPrefix(responseBytes, []byte("[")) {
+		var errorResp resp.Error
+		err = json.Unmarshal(responseBytes, &errorResp)
+		if err != nil {
+			return err
+		}
+
+		return RPCError{
+			Code:    errorResp.Error.Code,
+			Message: errorResp.Error.Message,
+		}
+	}
+
+	var responses []batchResponse
+	err = json.Unmarshal(responseBytes, &responses)
+	if err != nil {
+		return err
+	}
+
+	for i := range errs {
+		errs[i] = fmt.Errorf("no response to call %d of the batch from NEO node", i+1)
+	}
+
+	for _, response := range responses {
+		i := int(response.ID) - 1
+		if i < 0 || i >= len(calls) {
+			continue
+		}
+
+		if response.Error != nil {
+			errs[i] = RPCError{
+				Code:    response.Error.Code,
+				Message: response.Error.Message,
+			}
+			continue
+		}
+
+		errs[i] = json.Unmarshal(response.Result, calls[i].result)
+	}
+
+	return nil
+}
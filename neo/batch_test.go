@@ -0,0 +1,251 @@
+package neo_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatch(t *testing.T) {
+	addresses := []string{
+		testAccounts[0].publicAddress,
+		testAccounts[1].publicAddress,
+		testAccounts[2].publicAddress,
+	}
+
+	accountStateHandler := func(params []json.RawMessage) (interface{}, *testRPCError) {
+		var address string
+		_ = json.Unmarshal(params[0], &address)
+
+		if address == testAccounts[2].publicAddress {
+			return nil, &testRPCError{Code: -2146233033, Message: "One of the identified items was in an invalid format."}
+		}
+
+		return map[string]interface{}{
+			"script_hash": "0x" + address,
+			"balances": []map[string]string{
+				{
+					"asset": "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b",
+					"value": "10",
+				},
+			},
+		}, nil
+	}
+
+	unspentsHandler := func(params []json.RawMessage) (interface{}, *testRPCError) {
+		var address string
+		_ = json.Unmarshal(params[0], &address)
+
+		if address == testAccounts[2].publicAddress {
+			return nil, &testRPCError{Code: -2146233033, Message: "One of the identified items was in an invalid format."}
+		}
+
+		return json.RawMessage(`{
+			"balance": [
+				{
+					"unspent": [
+						{
+							"txid": "0x2d33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c74b",
+							"n": 1,
+							"value": 2.5
+						}
+					],
+					"asset_hash": "602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7",
+					"asset": "GAS",
+					"asset_symbol": "GAS",
+					"amount": 2.5
+				}
+			],
+			"address": "` + address + `"
+		}`), nil
+	}
+
+	t.Run(".GetAccountStates()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getaccountstate": accountStateHandler,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			states, err := client.GetAccountStates(context.Background(), addresses[:2], 2)
+			assert.NoError(t, err)
+			assert.Len(t, states, 2)
+
+			for _, address := range addresses[:2] {
+				assert.Equal(t, "0x"+address, states[address].ScriptHash)
+				assert.Equal(t, models.NewFixed8(10), states[address].Balances[0].Value)
+			}
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getaccountstate": accountStateHandler,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			states, err := client.GetAccountStates(context.Background(), addresses, 2)
+			assert.Len(t, states, 2)
+
+			batchErr, ok := err.(neo.BatchError)
+			assert.True(t, ok)
+			assert.Len(t, batchErr, 1)
+			assert.Error(t, batchErr[testAccounts[2].publicAddress])
+		})
+
+		t.Run("CancelledContext", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getaccountstate": accountStateHandler,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			states, err := client.GetAccountStates(ctx, addresses, 2)
+			assert.Equal(t, context.Canceled, err)
+			assert.Empty(t, states)
+			assert.Empty(t, node.Calls("getaccountstate"))
+		})
+	})
+
+	t.Run(".GetUnspentsMulti()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getunspents": unspentsHandler,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			unspents, err := client.GetUnspentsMulti(context.Background(), addresses[:2], 2)
+			assert.NoError(t, err)
+			assert.Len(t, unspents, 2)
+
+			for _, address := range addresses[:2] {
+				assert.Equal(t, address, unspents[address].Address)
+				assert.Equal(t, "GAS", unspents[address].Balances[0].AssetSymbol)
+
+				entry := unspents[address].Balances[0].Unspents[0]
+				assert.Equal(t, int64(1), entry.Index)
+				assert.Equal(t, "2.5", entry.Value.String())
+			}
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getunspents": unspentsHandler,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			unspents, err := client.GetUnspentsMulti(context.Background(), addresses, 2)
+			assert.Len(t, unspents, 2)
+
+			batchErr, ok := err.(neo.BatchError)
+			assert.True(t, ok)
+			assert.Len(t, batchErr, 1)
+			assert.Error(t, batchErr[testAccounts[2].publicAddress])
+		})
+
+		t.Run("CancelledContext", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getunspents": unspentsHandler,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			unspents, err := client.GetUnspentsMulti(ctx, addresses, 2)
+			assert.Equal(t, context.Canceled, err)
+			assert.Empty(t, unspents)
+			assert.Empty(t, node.Calls("getunspents"))
+		})
+
+		t.Run("SplitsBatches", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getunspents": unspentsHandler,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithMaxBatchSize(2))
+
+			manyAddresses := []string{"A1", "A2", "A3", "A4", "A5"}
+
+			unspents, err := client.GetUnspentsMulti(context.Background(), manyAddresses, 1)
+			assert.NoError(t, err)
+			assert.Len(t, unspents, 5)
+			assert.Equal(t, []int{2, 2, 1}, node.BatchSizes())
+
+			for _, address := range manyAddresses {
+				assert.Equal(t, address, unspents[address].Address)
+			}
+		})
+	})
+
+	t.Run(".AggregatedMempool()", func(t *testing.T) {
+		first := newTestNode(map[string]testHandler{
+			"getblockcount": testResult(100),
+			"getrawmempool": testResult([]string{"0x01", "0x02"}),
+		})
+		defer first.Close()
+
+		second := newTestNode(map[string]testHandler{
+			"getblockcount": testResult(100),
+			"getrawmempool": testResult([]string{"0x02", "0x03"}),
+		})
+		defer second.Close()
+
+		offline := newTestNode(map[string]testHandler{
+			"getblockcount": testResult(100),
+		})
+		defer offline.Close()
+
+		t.Run("HappyCase", func(t *testing.T) {
+			client, err := neo.NewClientUsingMultipleNodes([]string{first.URL, second.URL})
+			assert.NoError(t, err)
+
+			hashes, err := client.AggregatedMempool(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"0x01", "0x02", "0x03"}, hashes)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			client, err := neo.NewClientUsingMultipleNodes([]string{first.URL, offline.URL, second.URL})
+			assert.NoError(t, err)
+
+			hashes, err := client.AggregatedMempool(context.Background())
+			assert.Equal(t, []string{"0x01", "0x02", "0x03"}, hashes)
+
+			batchErr, ok := err.(neo.BatchError)
+			assert.True(t, ok)
+			assert.Len(t, batchErr, 1)
+			assert.Error(t, batchErr[offline.URL])
+		})
+
+		t.Run("CancelledContext", func(t *testing.T) {
+			client, err := neo.NewClientUsingMultipleNodes([]string{first.URL, second.URL})
+			assert.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			hashes, err := client.AggregatedMempool(ctx)
+			assert.Equal(t, context.Canceled, err)
+			assert.Empty(t, hashes)
+		})
+	})
+}
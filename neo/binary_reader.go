@@ -0,0 +1,109 @@
+package neo
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// errUnexpectedEnd is returned when serialized data ends before a value is fully read.
+var errUnexpectedEnd = errors.New("unexpected end of serialized data")
+
+type (
+	// binaryReader reads values serialized in the NEO binary format. The first error is
+	// kept in err and makes all further reads return zero values, so it only has to be
+	// checked once all of the values have been read.
+	binaryReader struct {
+		data   []byte
+		offset int
+		err    error
+	}
+)
+
+func newBinaryReader(data []byte) *binaryReader {
+	return &binaryReader{data: data}
+}
+
+func (r *binaryReader) readBytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+
+	if n < 0 || len(r.data)-r.offset < n {
+		r.err = errUnexpectedEnd
+		return nil
+	}
+
+	bytes := r.data[r.offset : r.offset+n]
+	r.offset += n
+
+	return bytes
+}
+
+func (r *binaryReader) readByte() byte {
+	bytes := r.readBytes(1)
+	if bytes == nil {
+		return 0
+	}
+
+	return bytes[0]
+}
+
+func (r *binaryReader) readUint32() uint32 {
+	bytes := r.readBytes(4)
+	if bytes == nil {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint32(bytes)
+}
+
+func (r *binaryReader) readUint64() uint64 {
+	bytes := r.readBytes(8)
+	if bytes == nil {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint64(bytes)
+}
+
+// readVarUint reads a variable length integer: values below 0xFD are a single byte,
+// otherwise the prefix 0xFD, 0xFE or 0xFF is followed by a 2, 4 or 8 byte integer.
+func (r *binaryReader) readVarUint() uint64 {
+	switch prefix := r.readByte(); prefix {
+	case 0xFD:
+		bytes := r.readBytes(2)
+		if bytes == nil {
+			return 0
+		}
+		return uint64(binary.LittleEndian.Uint16(bytes))
+	case 0xFE:
+		return uint64(r.readUint32())
+	case 0xFF:
+		return r.readUint64()
+	default:
+		return uint64(prefix)
+	}
+}
+
+// readVarBytes reads a byte array prefixed with its length as a variable length integer.
+func (r *binaryReader) readVarBytes() []byte {
+	length := r.readVarUint()
+	if length > uint64(len(r.data)) {
+		r.err = errUnexpectedEnd
+		return nil
+	}
+
+	return r.readBytes(int(length))
+}
+
+// readCount reads a variable length integer used as the number of items which follow,
+// each at least minItemSize bytes, failing when there are not enough bytes left for them.
+func (r *binaryReader) readCount(minItemSize int) int {
+	count := r.readVarUint()
+	if r.err == nil && count*uint64(minItemSize) > uint64(len(r.data)-r.offset) {
+		r.err = errUnexpectedEnd
+		return 0
+	}
+
+	return int(count)
+}
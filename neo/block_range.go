@@ -0,0 +1,173 @@
+package neo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+// linkageConcurrency is the number of calls in flight at a time while
+// VerifyChainLinkage fetches block headers.
+const linkageConcurrency = 4
+
+type (
+	// BlockError is returned by the block range methods when a block cannot be fetched,
+	// Index is the index of the offending block.
+	BlockError struct {
+		Index int64
+		Err   error
+	}
+)
+
+// Error implements the error interface.
+func (e BlockError) Error() string {
+	return fmt.Sprintf("unable to fetch block %d: %s", e.Index, e.Err)
+}
+
+// GetBlocksInRange fetches the blocks from start to end (both inclusive), with at most
+// concurrency calls in flight at a time, and returns them in index order. If a block
+// cannot be fetched no further blocks are requested and a BlockError is returned. When
+// ctx is done no further calls are started and ctx.Err() is returned.
+func (c Client) GetBlocksInRange(ctx context.Context, start, end int64, concurrency int) ([]models.Block, error) {
+	if end < start {
+		return nil, fmt.Errorf("end of block range (%d) is before start (%d)", end, start)
+	}
+
+	c = c.WithContext(ctx)
+	blocks := make([]models.Block, end-start+1)
+
+	err := forEachIndex(ctx, start, end, concurrency, func(index int64) error {
+		block, err := c.GetBlockByIndex(index)
+		if err != nil {
+			return BlockError{Index: index, Err: err}
+		}
+
+		blocks[index-start] = *block
+		return nil
+	})
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+// GetTransactionsInRange fetches the blocks from start to end (both inclusive) using
+// GetBlocksInRange, and returns all of their transactions in block order, and then in
+// the order they appear within each block.
+func (c Client) GetTransactionsInRange(ctx context.Context, start, end int64, concurrency int) ([]models.Transaction, error) {
+	blocks, err := c.GetBlocksInRange(ctx, start, end, concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	var transactions []models.Transaction
+	for _, block := range blocks {
+		transactions = append(transactions, block.Transactions...)
+	}
+
+	return transactions, nil
+}
+
+// VerifyChainLinkage checks that the blocks from start to end (both inclusive) form a
+// chain, with the previous block hash of each block matching the hash of the block before
+// it, as an integrity check of the node's chain or archived data. The block headers are
+// fetched with at most linkageConcurrency calls in flight at a time, and are then checked
+// in order. When a link is broken false is returned with the index of the first block
+// whose previous block hash does not match, otherwise true and -1 are returned.
+//
+// If a header cannot be fetched no further headers are requested and a BlockError is
+// returned. When ctx is done no further calls are started and ctx.Err() is returned.
+func (c Client) VerifyChainLinkage(ctx context.Context, start, end int64) (bool, int64, error) {
+	if end < start {
+		return false, -1, fmt.Errorf("end of block range (%d) is before start (%d)", end, start)
+	}
+
+	c = c.WithContext(ctx)
+
+	headers := make([]*models.BlockHeader, end-start+1)
+
+	err := forEachIndex(ctx, start, end, linkageConcurrency, func(index int64) error {
+		header, err := c.GetBlockHeaderByIndex(index)
+		if err != nil {
+			return BlockError{Index: index, Err: err}
+		}
+
+		headers[index-start] = header
+		return nil
+	})
+	if err != nil {
+		return false, -1, err
+	}
+
+	for i := 1; i < len(headers); i++ {
+		previousHash, _ := normalizeTxHash(headers[i-1].Hash)
+		linkedHash, ok := normalizeTxHash(headers[i].PreviousBlockHash)
+
+		if !ok || linkedHash != previousHash {
+			return false, start + int64(i), nil
+		}
+	}
+
+	return true, -1, nil
+}
+
+// forEachIndex calls fn for each index from start to end (both inclusive), using at most
+// concurrency goroutines. The first error returned by fn stops any further indexes being
+// handed out and is returned, as is ctx.Err() once ctx is done.
+func forEachIndex(ctx context.Context, start, end int64, concurrency int, fn func(index int64) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var once sync.Once
+	var firstErr error
+	var wg sync.WaitGroup
+	queue := make(chan int64)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for index := range queue {
+				if err := fn(index); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+queueLoop:
+	for index := start; index <= end; index++ {
+		if ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case queue <- index:
+		case <-ctx.Done():
+			break queueLoop
+		}
+	}
+
+	close(queue)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return ctx.Err()
+}
@@ -0,0 +1,167 @@
+package neo_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+// blockHandler answers getblock with a block holding two transactions, named after the
+// block index. The block at failIndex returns an error.
+func blockHandler(failIndex int64) testHandler {
+	return func(params []json.RawMessage) (interface{}, *testRPCError) {
+		var index int64
+		_ = json.Unmarshal(params[0], &index)
+
+		if index == failIndex {
+			return nil, &testRPCError{Code: -100, Message: "Unknown block"}
+		}
+
+		return map[string]interface{}{
+			"index": index,
+			"hash":  fmt.Sprintf("0x%064x", index),
+			"tx": []map[string]string{
+				{"txid": fmt.Sprintf("%d-0", index)},
+				{"txid": fmt.Sprintf("%d-1", index)},
+			},
+		}, nil
+	}
+}
+
+func TestBlockRange(t *testing.T) {
+	t.Run(".GetTransactionsInRange()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblock": blockHandler(-1),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			transactions, err := client.GetTransactionsInRange(context.Background(), 10, 14, 3)
+			assert.NoError(t, err)
+
+			var txIDs []string
+			for _, transaction := range transactions {
+				txIDs = append(txIDs, transaction.ID)
+			}
+
+			assert.Equal(t, []string{
+				"10-0", "10-1", "11-0", "11-1", "12-0", "12-1", "13-0", "13-1", "14-0", "14-1",
+			}, txIDs)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblock": blockHandler(12),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetTransactionsInRange(context.Background(), 10, 14, 1)
+			assert.Error(t, err)
+
+			blockErr, ok := err.(neo.BlockError)
+			assert.True(t, ok)
+			assert.Equal(t, int64(12), blockErr.Index)
+		})
+
+		t.Run("InvalidRange", func(t *testing.T) {
+			client := neo.NewClient("http://127.0.0.1:1")
+
+			_, err := client.GetTransactionsInRange(context.Background(), 14, 10, 1)
+			assert.Error(t, err)
+		})
+
+		t.Run("CancelledContext", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblock": blockHandler(-1),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := client.GetTransactionsInRange(ctx, 10, 14, 2)
+			assert.Equal(t, context.Canceled, err)
+			assert.Empty(t, node.Calls("getblock"))
+		})
+
+		t.Run("HangingNode", func(t *testing.T) {
+			node := newHangingNode()
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			_, err := client.GetTransactionsInRange(ctx, 10, 14, 2)
+			assert.Equal(t, context.DeadlineExceeded, err)
+		})
+	})
+	t.Run(".VerifyChainLinkage()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(testChain(100, 1500000000, 15))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			linked, index, err := client.VerifyChainLinkage(context.Background(), 10, 30)
+			assert.NoError(t, err)
+			assert.True(t, linked)
+			assert.Equal(t, int64(-1), index)
+			assert.Len(t, node.Calls("getblockheader"), 21)
+		})
+
+		t.Run("Tampered", func(t *testing.T) {
+			handlers := testChain(100, 1500000000, 15)
+			getBlockHeader := handlers["getblockheader"]
+			handlers["getblockheader"] = func(params []json.RawMessage) (interface{}, *testRPCError) {
+				header, rpcErr := getBlockHeader(params)
+				if string(params[0]) == "17" {
+					header.(map[string]interface{})["previousblockhash"] = testChainHash(99)
+				}
+
+				return header, rpcErr
+			}
+
+			node := newTestNode(handlers)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			linked, index, err := client.VerifyChainLinkage(context.Background(), 10, 30)
+			assert.NoError(t, err)
+			assert.False(t, linked)
+			assert.Equal(t, int64(17), index)
+		})
+
+		t.Run("MissingBlock", func(t *testing.T) {
+			node := newTestNode(testChain(20, 1500000000, 15))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, _, err := client.VerifyChainLinkage(context.Background(), 10, 30)
+			blockErr, ok := err.(neo.BlockError)
+			assert.True(t, ok)
+			assert.True(t, blockErr.Index > 20)
+		})
+
+		t.Run("InvalidRange", func(t *testing.T) {
+			client := neo.NewClient("http://localhost:10332")
+
+			_, _, err := client.VerifyChainLinkage(context.Background(), 30, 10)
+			assert.Error(t, err)
+		})
+	})
+}
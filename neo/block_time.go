@@ -0,0 +1,112 @@
+package neo
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+// blockTimeWindow is the number of recent blocks whose average interval is used by
+// EstimateBlockTime.
+const blockTimeWindow = 100
+
+// EstimateBlockTime estimates when the block at futureIndex will be produced, by
+// extrapolating from the best block using the average interval of the last 100 blocks.
+// It is only an estimate, the block interval varies with network conditions and
+// consensus delays. If the block has already been produced its actual time is returned.
+func (c Client) EstimateBlockTime(futureIndex int64) (time.Time, error) {
+	if futureIndex < 0 {
+		return time.Time{}, fmt.Errorf("block index must not be negative, got: %d", futureIndex)
+	}
+
+	best, err := c.GetBestBlockHeader()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if futureIndex <= best.Index {
+		header, err := c.GetBlockHeaderByIndex(futureIndex)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return c.blockTime(header)
+	}
+
+	interval, err := c.averageBlockTime(best, blockTimeWindow)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	bestTime, err := c.blockTime(best)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	remaining := time.Duration(futureIndex - best.Index)
+	return bestTime.Add(remaining * interval), nil
+}
+
+// AverageBlockTime returns the average interval between the last sampleBlocks blocks,
+// up to and including the best block, or between all the blocks when the chain is
+// shorter. Comparing it with the expected block interval of the network shows whether
+// blocks are being produced on time. sampleBlocks must be at least 2.
+func (c Client) AverageBlockTime(sampleBlocks int) (time.Duration, error) {
+	if sampleBlocks < 2 {
+		return 0, fmt.Errorf("at least 2 blocks must be sampled, got: %d", sampleBlocks)
+	}
+
+	best, err := c.GetBestBlockHeader()
+	if err != nil {
+		return 0, err
+	}
+
+	return c.averageBlockTime(best, int64(sampleBlocks-1))
+}
+
+// averageBlockTime returns the average interval between the window blocks up to and
+// including best, or fewer blocks when the chain is shorter.
+func (c Client) averageBlockTime(best *models.BlockHeader, window int64) (time.Duration, error) {
+	if window > best.Index {
+		window = best.Index
+	}
+
+	if window < 1 {
+		return 0, errors.New("Not enough blocks to work out the block time")
+	}
+
+	oldest, err := c.GetBlockHeaderByIndex(best.Index - window)
+	if err != nil {
+		return 0, err
+	}
+
+	bestTime, err := c.blockTime(best)
+	if err != nil {
+		return 0, err
+	}
+
+	oldestTime, err := c.blockTime(oldest)
+	if err != nil {
+		return 0, err
+	}
+
+	return bestTime.Sub(oldestTime) / time.Duration(window), nil
+}
+
+// blockTime returns the time the block was produced at. NEO2 block times are in seconds
+// while NEO3 block times are in milliseconds, so the error of detecting the network
+// generation is returned rather than guessing the unit.
+func (c Client) blockTime(header *models.BlockHeader) (time.Time, error) {
+	generation, err := c.NetworkGeneration()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if generation == NEO3 {
+		return time.Unix(0, header.Time*int64(time.Millisecond)), nil
+	}
+
+	return time.Unix(header.Time, 0), nil
+}
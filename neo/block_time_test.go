@@ -0,0 +1,124 @@
+package neo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockTime(t *testing.T) {
+	t.Run(".EstimateBlockTime()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(testChain(1000, 1500000000, 15))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			estimate, err := client.EstimateBlockTime(1240)
+			assert.NoError(t, err)
+			assert.Equal(t, time.Unix(1500000000+1240*15, 0), estimate)
+
+			calls := node.Calls("getblockheader")
+			assert.Len(t, calls, 2)
+			assert.Equal(t, "900", string(calls[1].Params[0]))
+		})
+
+		t.Run("AlreadyProduced", func(t *testing.T) {
+			node := newTestNode(testChain(1000, 1500000000, 15))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			blockTime, err := client.EstimateBlockTime(10)
+			assert.NoError(t, err)
+			assert.Equal(t, time.Unix(1500000150, 0), blockTime)
+		})
+
+		t.Run("ShortChain", func(t *testing.T) {
+			node := newTestNode(testChain(10, 1500000000, 20))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			estimate, err := client.EstimateBlockTime(15)
+			assert.NoError(t, err)
+			assert.Equal(t, time.Unix(1500000300, 0), estimate)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(testChain(0, 1500000000, 15))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			_, err := client.EstimateBlockTime(5)
+			assert.Error(t, err)
+
+			_, err = client.EstimateBlockTime(-1)
+			assert.Error(t, err)
+		})
+	})
+	t.Run(".AverageBlockTime()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(testChain(1000, 1500000000, 15))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			interval, err := client.AverageBlockTime(50)
+			assert.NoError(t, err)
+			assert.Equal(t, 15*time.Second, interval)
+
+			calls := node.Calls("getblockheader")
+			assert.Len(t, calls, 2)
+			assert.Equal(t, "951", string(calls[1].Params[0]))
+		})
+
+		t.Run("Milliseconds", func(t *testing.T) {
+			node := newTestNode(testChain(1000, 1600000000000, 15250))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO3))
+
+			interval, err := client.AverageBlockTime(10)
+			assert.NoError(t, err)
+			assert.Equal(t, 15250*time.Millisecond, interval)
+		})
+
+		t.Run("ShortChain", func(t *testing.T) {
+			node := newTestNode(testChain(4, 1500000000, 20))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			interval, err := client.AverageBlockTime(100)
+			assert.NoError(t, err)
+			assert.Equal(t, 20*time.Second, interval)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(testChain(0, 1500000000, 15))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			_, err := client.AverageBlockTime(10)
+			assert.Error(t, err)
+
+			_, err = client.AverageBlockTime(1)
+			assert.Error(t, err)
+		})
+
+		t.Run("NetworkUndetected", func(t *testing.T) {
+			node := newTestNode(testChain(1000, 1500000000, 15))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.AverageBlockTime(10)
+			assert.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,81 @@
+package neo
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+type (
+	// transactionCache holds confirmed transactions keyed by their hash. It is shared by
+	// copies of the Client which created it, so all access is guarded by the mutex.
+	transactionCache struct {
+		mutex      sync.Mutex
+		maxEntries int
+		hashes     []string
+		entries    map[string]models.Transaction
+	}
+)
+
+func newTransactionCache(maxEntries int) *transactionCache {
+	return &transactionCache{
+		maxEntries: maxEntries,
+		entries:    map[string]models.Transaction{},
+	}
+}
+
+// get returns a copy of the cached transaction for the hash, if there is one.
+func (t *transactionCache) get(hash string) (*models.Transaction, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	transaction, ok := t.entries[transactionCacheKey(hash)]
+	if !ok {
+		return nil, false
+	}
+
+	transaction = copyTransaction(transaction)
+
+	return &transaction, true
+}
+
+// add stores the transaction, as long as it has been confirmed. Transactions which are
+// still in the mempool are never stored as their state is about to change.
+func (t *transactionCache) add(transaction models.Transaction) {
+	if transaction.Confirmations < 1 || t.maxEntries < 1 {
+		return
+	}
+
+	key := transactionCacheKey(transaction.ID)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if _, ok := t.entries[key]; ok {
+		return
+	}
+
+	if len(t.hashes) >= t.maxEntries {
+		delete(t.entries, t.hashes[0])
+		t.hashes = t.hashes[1:]
+	}
+
+	t.hashes = append(t.hashes, key)
+	t.entries[key] = copyTransaction(transaction)
+}
+
+// copyTransaction returns a copy of the transaction which shares none of its slices, so
+// callers cannot change the cached transaction through them.
+func copyTransaction(transaction models.Transaction) models.Transaction {
+	transaction.Attributes = append([]models.TransactionAttribute(nil), transaction.Attributes...)
+	transaction.Vin = append([]models.Vin(nil), transaction.Vin...)
+	transaction.Vout = append([]models.Vout(nil), transaction.Vout...)
+	transaction.Scripts = append([]models.Script(nil), transaction.Scripts...)
+
+	return transaction
+}
+
+func transactionCacheKey(hash string) string {
+	return strings.TrimPrefix(strings.ToLower(hash), "0x")
+}
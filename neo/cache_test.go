@@ -0,0 +1,126 @@
+package neo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionCache(t *testing.T) {
+	hash := "0xc515c4d2db27e06fd2305a5c5378f820d2c4cc04477ebe40ffa40b956eb4f8b5"
+
+	t.Run("UnconfirmedThenConfirmed", func(t *testing.T) {
+		confirmations := 0
+		node := newTestNode(map[string]testHandler{
+			"getrawtransaction": func([]json.RawMessage) (interface{}, *testRPCError) {
+				return map[string]interface{}{
+					"txid":          hash,
+					"confirmations": confirmations,
+				}, nil
+			},
+		})
+		defer node.Close()
+
+		client := neo.NewClient(node.URL, neo.WithTransactionCache(10))
+
+		transaction, err := client.GetTransaction(hash)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, transaction.Confirmations)
+
+		confirmations = 1
+
+		transaction, err = client.GetTransaction(hash)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, transaction.Confirmations)
+		assert.Len(t, node.Calls("getrawtransaction"), 2)
+
+		confirmations = 2
+
+		transaction, err = client.GetTransaction(hash[2:])
+		assert.NoError(t, err)
+		assert.Equal(t, 1, transaction.Confirmations)
+		assert.Len(t, node.Calls("getrawtransaction"), 2)
+	})
+
+	t.Run("Eviction", func(t *testing.T) {
+		node := newTestNode(map[string]testHandler{
+			"getrawtransaction": func(params []json.RawMessage) (interface{}, *testRPCError) {
+				var txID string
+				_ = json.Unmarshal(params[0], &txID)
+
+				return map[string]interface{}{
+					"txid":          txID,
+					"confirmations": 1,
+				}, nil
+			},
+		})
+		defer node.Close()
+
+		client := neo.NewClient(node.URL, neo.WithTransactionCache(1))
+
+		_, err := client.GetTransaction("0x01")
+		assert.NoError(t, err)
+		_, err = client.GetTransaction("0x02")
+		assert.NoError(t, err)
+		_, err = client.GetTransaction("0x01")
+		assert.NoError(t, err)
+
+		assert.Len(t, node.Calls("getrawtransaction"), 3)
+	})
+
+	t.Run("ReturnsCopies", func(t *testing.T) {
+		node := newTestNode(map[string]testHandler{
+			"getrawtransaction": testRawResult(`{
+				"txid": "0x01",
+				"confirmations": 1,
+				"attributes": [{"usage": "Remark", "data": "00"}],
+				"vin": [{"txid": "0x02", "vout": 0}],
+				"vout": [{"address": "` + testAccounts[0].publicAddress + `", "n": 0, "value": "1"}],
+				"scripts": [{"invocation": "00", "verification": "00"}]
+			}`),
+		})
+		defer node.Close()
+
+		client := neo.NewClient(node.URL, neo.WithTransactionCache(10))
+
+		transaction, err := client.GetTransaction("0x01")
+		assert.NoError(t, err)
+
+		transaction.Attributes[0].Data = "ff"
+		transaction.Vin[0].TransactionID = "0xff"
+		transaction.Vout[0].Address = testAccounts[1].publicAddress
+		transaction.Scripts[0].Invocation = "ff"
+
+		transaction, err = client.GetTransaction("0x01")
+		assert.NoError(t, err)
+		assert.Equal(t, "00", transaction.Attributes[0].Data)
+		assert.Equal(t, "0x02", transaction.Vin[0].TransactionID)
+		assert.Equal(t, testAccounts[0].publicAddress, transaction.Vout[0].Address)
+		assert.Equal(t, "00", transaction.Scripts[0].Invocation)
+
+		transaction.Vout[0].Address = testAccounts[1].publicAddress
+
+		transaction, err = client.GetTransaction("0x01")
+		assert.NoError(t, err)
+		assert.Equal(t, testAccounts[0].publicAddress, transaction.Vout[0].Address)
+		assert.Len(t, node.Calls("getrawtransaction"), 1)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		node := newTestNode(map[string]testHandler{
+			"getrawtransaction": testRawResult(`{"txid": "0x01", "confirmations": 1}`),
+		})
+		defer node.Close()
+
+		client := neo.NewClient(node.URL)
+
+		_, err := client.GetTransaction("0x01")
+		assert.NoError(t, err)
+		_, err = client.GetTransaction("0x01")
+		assert.NoError(t, err)
+
+		assert.Len(t, node.Calls("getrawtransaction"), 2)
+	})
+}
@@ -0,0 +1,105 @@
+package neo
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+)
+
+// ErrCertificateNotPinned is returned when the certificate presented by a node does not
+// match any of the pins set with WithCertPinning.
+var ErrCertificateNotPinned = errors.New("certificate presented by the NEO node does not match any pin")
+
+// ErrPinningUnsupportedTransport is returned by every request of a Client created with
+// WithCertPinning and WithHTTPClient, when the transport of the HTTP client is not an
+// *http.Transport, so the pins cannot be added to it.
+var ErrPinningUnsupportedTransport = errors.New("certificate pinning requires the HTTP client's transport to be an *http.Transport")
+
+type (
+	// errorTransport is an http.RoundTripper which fails every request with err.
+	errorTransport struct {
+		err error
+	}
+)
+
+// applyCertPinning replaces the Client's HTTP client with a pinned copy when
+// WithCertPinning was used. The constructors call it once all options have run, so the
+// pins apply to an HTTP client set with WithHTTPClient whichever option comes first.
+func (c *Client) applyCertPinning() {
+	if !c.certPinning {
+		return
+	}
+
+	c.httpClient = newPinnedHTTPClient(c.getHTTPClient(), c.certificatePins)
+}
+
+// newPinnedHTTPClient returns a copy of the HTTP client which only connects to nodes
+// presenting a certificate whose public key matches one of the pins. The transport of the
+// client is cloned, or the default transport when it has none. Any other http.RoundTripper
+// cannot be pinned, so the copy then fails every request with
+// ErrPinningUnsupportedTransport rather than connecting without the pins.
+func newPinnedHTTPClient(httpClient *http.Client, pins [][]byte) *http.Client {
+	pinnedClient := *httpClient
+
+	transport := http.DefaultTransport.(*http.Transport)
+	if httpClient.Transport != nil {
+		customTransport, ok := httpClient.Transport.(*http.Transport)
+		if !ok {
+			pinnedClient.Transport = errorTransport{err: ErrPinningUnsupportedTransport}
+			return &pinnedClient
+		}
+
+		transport = customTransport
+	}
+	transport = transport.Clone()
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+
+	// the pin is checked in place of the certificate authorities
+	transport.TLSClientConfig.InsecureSkipVerify = true
+	transport.TLSClientConfig.VerifyPeerCertificate = verifyPinnedCertificate(pins)
+
+	pinnedClient.Transport = transport
+
+	return &pinnedClient
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t errorTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Body != nil {
+		r.Body.Close()
+	}
+
+	return nil, t.err
+}
+
+// verifyPinnedCertificate returns a tls.Config VerifyPeerCertificate function which
+// checks the SHA-256 of the SubjectPublicKeyInfo of the leaf certificate against the pins.
+// Only the leaf is checked, as the TLS handshake proves that the node holds its private
+// key but not that of any other certificate in the chain.
+func verifyPinnedCertificate(pins [][]byte) func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return ErrCertificateNotPinned
+		}
+
+		certificate, err := x509.ParseCertificate(rawCerts[0])
+		if err != nil {
+			return err
+		}
+
+		hash := sha256.Sum256(certificate.RawSubjectPublicKeyInfo)
+		for _, pin := range pins {
+			if bytes.Equal(pin, hash[:]) {
+				return nil
+			}
+		}
+
+		return ErrCertificateNotPinned
+	}
+}
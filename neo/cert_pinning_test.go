@@ -0,0 +1,120 @@
+package neo_test
+
+import (
+	"crypto/sha256"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertPinning(t *testing.T) {
+	node := newTLSTestNode(map[string]testHandler{
+		"getblockcount": testResult(100),
+	})
+	defer node.Close()
+
+	pin := sha256.Sum256(node.Certificate().RawSubjectPublicKeyInfo)
+	otherPin := sha256.Sum256([]byte("another public key"))
+
+	t.Run("WithCertPinning()", func(t *testing.T) {
+		t.Run("MatchingCertificate", func(t *testing.T) {
+			client := neo.NewClient(node.URL, neo.WithCertPinning([][]byte{otherPin[:], pin[:]}))
+
+			blockCount, err := client.GetBlockCount()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100), blockCount)
+			assert.Equal(t, 2, client.Config().CertificatePins)
+		})
+
+		t.Run("MismatchingCertificate", func(t *testing.T) {
+			client := neo.NewClient(node.URL, neo.WithCertPinning([][]byte{otherPin[:]}))
+			calls := len(node.Calls("getblockcount"))
+
+			_, err := client.GetBlockCount()
+			assert.Error(t, err)
+			assert.Contains(t, errors.Cause(err).Error(), neo.ErrCertificateNotPinned.Error())
+			assert.Len(t, node.Calls("getblockcount"), calls)
+		})
+
+		t.Run("WithHTTPClient", func(t *testing.T) {
+			httpClient := &http.Client{Timeout: time.Minute}
+
+			client := neo.NewClient(
+				node.URL,
+				neo.WithHTTPClient(httpClient),
+				neo.WithCertPinning([][]byte{pin[:]}),
+			)
+
+			_, err := client.GetBlockCount()
+			assert.NoError(t, err)
+			assert.Nil(t, httpClient.Transport)
+		})
+
+		t.Run("WithHTTPClientAfter", func(t *testing.T) {
+			httpClient := &http.Client{Timeout: time.Minute}
+
+			client := neo.NewClient(
+				node.URL,
+				neo.WithCertPinning([][]byte{otherPin[:]}),
+				neo.WithHTTPClient(httpClient),
+			)
+
+			_, err := client.GetBlockCount()
+			assert.Error(t, err)
+			assert.Contains(t, errors.Cause(err).Error(), neo.ErrCertificateNotPinned.Error())
+			assert.Nil(t, httpClient.Transport)
+		})
+
+		t.Run("UnsupportedTransport", func(t *testing.T) {
+			var calls int32
+			transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				atomic.AddInt32(&calls, 1)
+				return http.DefaultTransport.RoundTrip(r)
+			})
+
+			client := neo.NewClient(
+				node.URL,
+				neo.WithHTTPClient(&http.Client{Transport: transport}),
+				neo.WithCertPinning([][]byte{pin[:]}),
+			)
+
+			_, err := client.GetBlockCount()
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), neo.ErrPinningUnsupportedTransport.Error())
+			assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+		})
+
+		t.Run("MultipleNodes", func(t *testing.T) {
+			client, err := neo.NewClientUsingMultipleNodes(
+				[]string{node.URL},
+				neo.WithCertPinning([][]byte{pin[:]}),
+				neo.WithHTTPClient(&http.Client{Timeout: time.Minute}),
+			)
+			assert.NoError(t, err)
+
+			blockCount, err := client.GetBlockCount()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100), blockCount)
+		})
+
+		t.Run("NoPins", func(t *testing.T) {
+			client := neo.NewClient(node.URL, neo.WithCertPinning(nil))
+
+			_, err := client.GetBlockCount()
+			assert.Error(t, err)
+		})
+
+		t.Run("WithoutPinning", func(t *testing.T) {
+			client := neo.NewClient(node.URL)
+
+			// the self-signed certificate is rejected by the certificate authorities
+			_, err := client.GetBlockCount()
+			assert.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,53 @@
+package neo
+
+import "time"
+
+type (
+	// ChainTip describes the best block known to the node, and how long ago it was
+	// produced.
+	ChainTip struct {
+		Index     int64
+		Hash      string
+		Time      time.Time
+		Staleness time.Duration
+	}
+)
+
+// GetChainTip returns the node's best block along with how long ago it was produced. A
+// node which is online but not advancing its tip has a growing Staleness, which a height
+// comparison across nodes misses when all of them are equally stuck.
+func (c Client) GetChainTip() (ChainTip, error) {
+	best, err := c.GetBestBlockHeader()
+	if err != nil {
+		return ChainTip{}, err
+	}
+
+	bestTime, err := c.blockTime(best)
+	if err != nil {
+		return ChainTip{}, err
+	}
+
+	tip := ChainTip{
+		Index: best.Index,
+		Hash:  best.Hash,
+		Time:  bestTime,
+	}
+
+	// a block time slightly ahead of the local clock is not staleness
+	if staleness := time.Since(tip.Time); staleness > 0 {
+		tip.Staleness = staleness
+	}
+
+	return tip, nil
+}
+
+// TipStaleness returns how long ago the node's best block was produced, so that callers
+// can alert when it is well beyond the expected block interval, such as 5 minutes.
+func (c Client) TipStaleness() (time.Duration, error) {
+	tip, err := c.GetChainTip()
+	if err != nil {
+		return 0, err
+	}
+
+	return tip.Staleness, nil
+}
@@ -0,0 +1,77 @@
+package neo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainTip(t *testing.T) {
+	t.Run(".GetChainTip()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			tipTime := time.Now().Add(-10 * time.Minute).Unix()
+
+			node := newTestNode(testChain(1000, tipTime-1000*15, 15))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			tip, err := client.GetChainTip()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1000), tip.Index)
+			assert.Equal(t, testChainHash(1000), tip.Hash)
+			assert.Equal(t, time.Unix(tipTime, 0), tip.Time)
+			assert.InDelta(t, float64(10*time.Minute), float64(tip.Staleness), float64(5*time.Second))
+		})
+
+		t.Run("TipAheadOfClock", func(t *testing.T) {
+			tipTime := time.Now().Add(time.Minute).Unix()
+
+			node := newTestNode(testChain(1000, tipTime-1000*15, 15))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			tip, err := client.GetChainTip()
+			assert.NoError(t, err)
+			assert.Equal(t, time.Duration(0), tip.Staleness)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			_, err := client.GetChainTip()
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run(".TipStaleness()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			tipTime := time.Now().Add(-5 * time.Minute).Unix()
+
+			node := newTestNode(testChain(20, tipTime-20*15, 15))
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			staleness, err := client.TipStaleness()
+			assert.NoError(t, err)
+			assert.InDelta(t, float64(5*time.Minute), float64(staleness), float64(5*time.Second))
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			_, err := client.TipStaleness()
+			assert.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,188 @@
+package neo
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	// BreakerState is the state of the circuit breaker of a node.
+	BreakerState string
+
+	// NodeStatus holds the routing state of one of the Client's nodes.
+	NodeStatus struct {
+		URI                 string
+		Active              bool
+		Breaker             BreakerState
+		ConsecutiveFailures int
+		SoftwareVersion     string
+	}
+
+	// circuitBreakers tracks the failures of each node URI. It is shared by copies of the
+	// Client which created it, so all access is guarded by the mutex.
+	circuitBreakers struct {
+		mutex            sync.Mutex
+		failureThreshold int
+		cooldown         time.Duration
+		nodes            map[string]*circuitBreaker
+	}
+
+	circuitBreaker struct {
+		state               BreakerState
+		consecutiveFailures int
+		openedAt            time.Time
+	}
+)
+
+const (
+	// BreakerClosed means requests are sent to the node as normal.
+	BreakerClosed BreakerState = "closed"
+
+	// BreakerOpen means the node has failed too many times in a row, and is skipped until
+	// the cooldown period has passed.
+	BreakerOpen BreakerState = "open"
+
+	// BreakerHalfOpen means the cooldown period has passed and a single trial request is
+	// being sent to the node, its outcome closes or re-opens the breaker.
+	BreakerHalfOpen BreakerState = "half-open"
+)
+
+func newCircuitBreakers(failureThreshold int, cooldown time.Duration) *circuitBreakers {
+	return &circuitBreakers{
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		nodes:            map[string]*circuitBreaker{},
+	}
+}
+
+// allow reports whether a request may be sent to the node. Once the cooldown of an open
+// breaker has passed a single trial request is allowed, moving it to half-open.
+func (b *circuitBreakers) allow(nodeURI string) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	breaker := b.breaker(nodeURI)
+
+	switch breaker.state {
+	case BreakerOpen:
+		if time.Since(breaker.openedAt) < b.cooldown {
+			return false
+		}
+
+		breaker.state = BreakerHalfOpen
+		return true
+	case BreakerHalfOpen:
+		return false
+	}
+
+	return true
+}
+
+// record stores the outcome of a request sent to the node.
+func (b *circuitBreakers) record(nodeURI string, success bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	breaker := b.breaker(nodeURI)
+
+	if success {
+		breaker.state = BreakerClosed
+		breaker.consecutiveFailures = 0
+		return
+	}
+
+	breaker.consecutiveFailures++
+
+	if breaker.state == BreakerHalfOpen || breaker.consecutiveFailures >= b.failureThreshold {
+		breaker.state = BreakerOpen
+		breaker.openedAt = time.Now()
+	}
+}
+
+// abandon releases the trial request of a half-open breaker whose request was abandoned
+// by the caller before the node responded, so that the next request is a new trial. The
+// outcome of the abandoned request says nothing of the node, so it is not recorded.
+func (b *circuitBreakers) abandon(nodeURI string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	breaker := b.breaker(nodeURI)
+	if breaker.state == BreakerHalfOpen {
+		breaker.state = BreakerOpen
+	}
+}
+
+// status returns the state and number of consecutive failures of the node.
+func (b *circuitBreakers) status(nodeURI string) (BreakerState, int) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	breaker := b.breaker(nodeURI)
+	return breaker.state, breaker.consecutiveFailures
+}
+
+func (b *circuitBreakers) breaker(nodeURI string) *circuitBreaker {
+	breaker, ok := b.nodes[nodeURI]
+	if !ok {
+		breaker = &circuitBreaker{state: BreakerClosed}
+		b.nodes[nodeURI] = breaker
+	}
+
+	return breaker
+}
+
+// NodeStatuses returns the status of each of the Client's nodes. When the circuit breaker
+// is not enabled every node is reported as closed. The software version is only set for
+// nodes which have been negotiated with, see Negotiate.
+func (c Client) NodeStatuses() []NodeStatus {
+	statuses := make([]NodeStatus, 0, len(c.nodeURIs))
+
+	for _, nodeURI := range c.nodeURIs {
+		status := NodeStatus{
+			URI:     nodeURI,
+			Active:  nodeURI == c.Node,
+			Breaker: BreakerClosed,
+		}
+
+		if c.circuitBreakers != nil {
+			status.Breaker, status.ConsecutiveFailures = c.circuitBreakers.status(nodeURI)
+		}
+
+		if version, ok := c.network.node(nodeURI); ok {
+			status.SoftwareVersion = version.SoftwareVersion
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// candidateNodes returns the nodes a request may be sent to, in order. Without a circuit
+// breaker only the active node is used. With one, the active node is followed by the
+// other nodes so that a failing node is failed over.
+func (c Client) candidateNodes() []string {
+	if c.circuitBreakers == nil {
+		return []string{c.Node}
+	}
+
+	nodeURIs := []string{c.Node}
+	for _, nodeURI := range c.nodeURIs {
+		if nodeURI != c.Node {
+			nodeURIs = append(nodeURIs, nodeURI)
+		}
+	}
+
+	return nodeURIs
+}
+
+// forNode returns a copy of the Client which only sends requests to the given node, it is
+// used to probe each node individually. Probes do not change the active node, so they do
+// not notify OnNodeChange.
+func (c Client) forNode(nodeURI string) Client {
+	c.Node = nodeURI
+	c.nodeURIs = []string{nodeURI}
+	c.nodeChange = nil
+
+	return c
+}
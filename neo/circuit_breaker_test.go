@@ -0,0 +1,203 @@
+package neo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var failing int32 = 1
+	var flakyCalls int32
+
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&flakyCalls, 1)
+		if atomic.LoadInt32(&failing) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": 1, "jsonrpc": "2.0", "result": 200}`))
+	}))
+	defer flaky.Close()
+
+	healthy := newTestNode(map[string]testHandler{
+		"getblockcount": testResult(100),
+	})
+	defer healthy.Close()
+
+	client, err := neo.NewClientUsingMultipleNodes(
+		[]string{flaky.URL, healthy.URL},
+		neo.WithCircuitBreaker(2, 50*time.Millisecond),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, healthy.URL, client.Node)
+
+	// Make the flaky node the active one, so requests have to fail over.
+	client.Node = flaky.URL
+
+	t.Run("FailsOver", func(t *testing.T) {
+		blockCount, err := client.GetBlockCount()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), blockCount)
+
+		statuses := client.NodeStatuses()
+		assert.Equal(t, neo.BreakerOpen, statuses[0].Breaker)
+		assert.Equal(t, 2, statuses[0].ConsecutiveFailures)
+		assert.True(t, statuses[0].Active)
+		assert.Equal(t, neo.BreakerClosed, statuses[1].Breaker)
+	})
+
+	t.Run("SkipsOpenNode", func(t *testing.T) {
+		calls := atomic.LoadInt32(&flakyCalls)
+
+		_, err := client.GetBlockCount()
+		assert.NoError(t, err)
+		assert.Equal(t, calls, atomic.LoadInt32(&flakyCalls))
+	})
+
+	t.Run("Recovers", func(t *testing.T) {
+		atomic.StoreInt32(&failing, 0)
+		time.Sleep(60 * time.Millisecond)
+
+		blockCount, err := client.GetBlockCount()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(200), blockCount)
+
+		statuses := client.NodeStatuses()
+		assert.Equal(t, neo.BreakerClosed, statuses[0].Breaker)
+		assert.Equal(t, 0, statuses[0].ConsecutiveFailures)
+	})
+
+	t.Run("AllNodesOpen", func(t *testing.T) {
+		offline := neo.NewClient("http://127.0.0.1:1", neo.WithCircuitBreaker(1, time.Minute))
+
+		_, err := offline.GetBlockCount()
+		assert.Error(t, err)
+
+		_, err = offline.GetBlockCount()
+		assert.Equal(t, neo.ErrAllNodesUnavailable, err)
+	})
+	t.Run("HangingNode", func(t *testing.T) {
+		hanging := newHangingNode()
+		defer hanging.Close()
+
+		healthy := newTestNode(map[string]testHandler{
+			"getblockcount": testResult(100),
+		})
+		defer healthy.Close()
+
+		client := neo.NewClient(
+			hanging.URL,
+			neo.WithCircuitBreaker(2, time.Minute),
+			neo.WithTimeout(50*time.Millisecond),
+		)
+
+		t.Run("OpensBreaker", func(t *testing.T) {
+			for i := 0; i < 2; i++ {
+				_, err := client.GetBlockCount()
+				assert.True(t, errors.Is(err, context.DeadlineExceeded))
+			}
+
+			statuses := client.NodeStatuses()
+			assert.Equal(t, neo.BreakerOpen, statuses[0].Breaker)
+			assert.Equal(t, 2, statuses[0].ConsecutiveFailures)
+		})
+
+		t.Run("FailsOver", func(t *testing.T) {
+			client, err := neo.NewClientUsingMultipleNodes(
+				[]string{hanging.URL, healthy.URL},
+				neo.WithCircuitBreaker(2, time.Minute),
+				neo.WithTimeout(50*time.Millisecond),
+			)
+			assert.NoError(t, err)
+
+			// Make the hanging node the active one, so requests have to fail over.
+			client.Node = hanging.URL
+
+			start := time.Now()
+			blockCount, err := client.GetBlockCount()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100), blockCount)
+			assert.True(t, time.Since(start) < time.Second)
+
+			// the block count probe of NewClientUsingMultipleNodes timed out too
+			statuses := client.NodeStatuses()
+			assert.Equal(t, neo.BreakerOpen, statuses[0].Breaker)
+			assert.Equal(t, 2, statuses[0].ConsecutiveFailures)
+			assert.Equal(t, neo.BreakerClosed, statuses[1].Breaker)
+		})
+	})
+	t.Run("WalletMethod", func(t *testing.T) {
+		failingNode := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer failingNode.Close()
+
+		walletNode := newTestNode(map[string]testHandler{
+			"sendtoaddress": testResult(map[string]string{"txid": "0x01"}),
+			"importprivkey": testResult(map[string]string{"address": testAccounts[0].publicAddress}),
+		})
+		defer walletNode.Close()
+
+		client, err := neo.NewClientUsingMultipleNodes(
+			[]string{failingNode.URL, walletNode.URL},
+			neo.WithCircuitBreaker(5, time.Minute),
+		)
+		assert.NoError(t, err)
+		client.Node = failingNode.URL
+
+		_, err = client.SendToAddress(neo.GASAssetID, testAccounts[0].publicAddress, 1)
+		assert.Error(t, err)
+
+		_, err = client.ImportPrivKey(testAccounts[0].wif)
+		assert.Error(t, err)
+
+		assert.Empty(t, walletNode.Calls("sendtoaddress"))
+		assert.Empty(t, walletNode.Calls("importprivkey"))
+	})
+
+	t.Run("AbandonedTrial", func(t *testing.T) {
+		var failing int32 = 1
+
+		node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.LoadInt32(&failing) == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"id": 1, "jsonrpc": "2.0", "result": 200}`))
+		}))
+		defer node.Close()
+
+		client := neo.NewClient(node.URL, neo.WithCircuitBreaker(1, 20*time.Millisecond))
+
+		_, err := client.GetBlockCount()
+		assert.Error(t, err)
+		assert.Equal(t, neo.BreakerOpen, client.NodeStatuses()[0].Breaker)
+
+		time.Sleep(30 * time.Millisecond)
+
+		// the trial request is abandoned by the caller before the node responds
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err = client.WithContext(ctx).GetBlockCount()
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.Equal(t, neo.BreakerOpen, client.NodeStatuses()[0].Breaker)
+
+		atomic.StoreInt32(&failing, 0)
+
+		blockCount, err := client.GetBlockCount()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(200), blockCount)
+		assert.Equal(t, neo.BreakerClosed, client.NodeStatuses()[0].Breaker)
+	})
+}
@@ -0,0 +1,141 @@
+package neo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/lomocoin/neo-go-sdk/neo/models/response"
+)
+
+// GetClaimable returns the spent NEO outputs of the address from which GAS can be
+// claimed, which are passed to BuildClaimTransaction. It is only supported by NEO2 nodes,
+// with the RpcSystemAssetTracker plugin installed.
+func (c Client) GetClaimable(address string) (*models.Claimable, error) {
+	if err := c.checkNetworkGeneration(NEO2); err != nil {
+		return nil, err
+	}
+
+	requestBodyParams := []interface{}{
+		address,
+	}
+	var resp response.Claimable
+
+	err := c.executeRequest("getclaimable", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// GetUnclaimed returns the GAS of the address which is yet to be claimed, both from spent
+// NEO outputs, which GetClaimable lists, and from unspent ones. Like GetClaimable it is
+// only supported by NEO2 nodes, with the RpcSystemAssetTracker plugin installed.
+func (c Client) GetUnclaimed(address string) (*models.Unclaimed, error) {
+	if err := c.checkNetworkGeneration(NEO2); err != nil {
+		return nil, err
+	}
+
+	requestBodyParams := []interface{}{
+		address,
+	}
+	var resp response.Unclaimed
+
+	err := c.executeRequest("getunclaimed", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// BuildClaimTransaction builds a NEO2 ClaimTransaction, without the node's wallet, which
+// claims the GAS of the claimable outputs returned by GetClaimable and pays it, in a
+// single output, to the address. The transaction is returned serialized and hex encoded,
+// without its witnesses: those bytes are what the owner of the outputs signs, and the
+// double SHA-256 of them is the transaction ID. Once the witness has been appended the
+// transaction can be sent with SendRawTransactions.
+//
+// An error is returned if there are no claims, a claim is malformed or repeated, or a
+// claim has no GAS to claim.
+func BuildClaimTransaction(address string, claims []models.ClaimableOutput) (string, error) {
+	if len(claims) == 0 {
+		return "", errors.New("there are no claims to claim GAS from")
+	}
+
+	_, scriptHash, err := decodeAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+	var total models.Fixed8
+	seen := make(map[string]bool, len(claims))
+
+	buffer.WriteByte(claimTransaction)
+	buffer.WriteByte(0)
+	writeVarUint(&buffer, uint64(len(claims)))
+
+	for _, claim := range claims {
+		txHash, ok := normalizeTxHash(claim.TransactionID)
+		if !ok {
+			return "", fmt.Errorf("claim has an invalid transaction ID: '%s'", claim.TransactionID)
+		}
+
+		if claim.Index < 0 || claim.Index > 0xFFFF {
+			return "", fmt.Errorf("claim of %s has an invalid output index: %d", txHash, claim.Index)
+		}
+
+		key := fmt.Sprintf("%s:%d", txHash, claim.Index)
+		if seen[key] {
+			return "", fmt.Errorf("output %s is claimed more than once", key)
+		}
+		seen[key] = true
+
+		if claim.Unclaimed <= 0 {
+			return "", fmt.Errorf("output %s has no GAS to claim", key)
+		}
+
+		total += claim.Unclaimed
+
+		hash, _ := hex.DecodeString(strings.TrimPrefix(txHash, "0x"))
+		buffer.Write(reverseBytes(hash))
+		_ = binary.Write(&buffer, binary.LittleEndian, uint16(claim.Index))
+	}
+
+	// no attributes or inputs
+	writeVarUint(&buffer, 0)
+	writeVarUint(&buffer, 0)
+
+	asset, _ := hex.DecodeString(strings.TrimPrefix(GASAssetID, "0x"))
+
+	writeVarUint(&buffer, 1)
+	buffer.Write(reverseBytes(asset))
+	_ = binary.Write(&buffer, binary.LittleEndian, int64(total))
+	buffer.Write(scriptHash)
+
+	return hex.EncodeToString(buffer.Bytes()), nil
+}
+
+// writeVarUint writes the value as a variable length integer, the counterpart of
+// binaryReader.readVarUint.
+func writeVarUint(buffer *bytes.Buffer, value uint64) {
+	switch {
+	case value < 0xFD:
+		buffer.WriteByte(byte(value))
+	case value <= 0xFFFF:
+		buffer.WriteByte(0xFD)
+		_ = binary.Write(buffer, binary.LittleEndian, uint16(value))
+	case value <= 0xFFFFFFFF:
+		buffer.WriteByte(0xFE)
+		_ = binary.Write(buffer, binary.LittleEndian, uint32(value))
+	default:
+		buffer.WriteByte(0xFF)
+		_ = binary.Write(buffer, binary.LittleEndian, value)
+	}
+}
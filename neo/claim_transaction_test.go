@@ -0,0 +1,144 @@
+package neo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimTransaction(t *testing.T) {
+	claims := []models.ClaimableOutput{
+		{
+			TransactionID: testTransactions[0].hash,
+			Index:         0,
+			Unclaimed:     64,
+		},
+		{
+			TransactionID: testTransactionOutputs[0].hash,
+			Index:         1,
+			Unclaimed:     120000000,
+		},
+	}
+
+	t.Run(".GetClaimable()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getclaimable": testRawResult(`{
+					"claimable": [
+						{
+							"txid": "` + testTransactions[0].hash + `",
+							"n": 0,
+							"value": 1,
+							"start_height": 100,
+							"end_height": 108,
+							"generated": 0.00000064,
+							"sys_fee": 0,
+							"unclaimed": 0.00000064
+						}
+					],
+					"address": "` + testAccounts[0].publicAddress + `",
+					"unclaimed": 0.00000064
+				}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			claimable, err := client.GetClaimable(testAccounts[0].publicAddress)
+			assert.NoError(t, err)
+			assert.Equal(t, models.Fixed8(64), claimable.Unclaimed)
+			assert.Equal(t, []models.ClaimableOutput{
+				{
+					TransactionID: testTransactions[0].hash,
+					Index:         0,
+					Value:         models.NewFixed8(1),
+					StartHeight:   100,
+					EndHeight:     108,
+					Generated:     64,
+					Unclaimed:     64,
+				},
+			}, claimable.Claims)
+		})
+
+		t.Run("NEO3Node", func(t *testing.T) {
+			client := neo.NewClient("http://localhost:10332", neo.WithNetworkGeneration(neo.NEO3))
+
+			_, err := client.GetClaimable(testAccounts[0].publicAddress)
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, err)
+		})
+	})
+
+	t.Run(".GetUnclaimed()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getunclaimed": testRawResult(`{
+					"available": 0.00000064,
+					"unavailable": "1.4918268",
+					"unclaimed": 1.49182744
+				}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			unclaimed, err := client.GetUnclaimed(testAccounts[0].publicAddress)
+			assert.NoError(t, err)
+			assert.Equal(t, &models.Unclaimed{
+				Available:   64,
+				Unavailable: 149182680,
+				Unclaimed:   149182744,
+			}, unclaimed)
+		})
+
+		t.Run("NEO3Node", func(t *testing.T) {
+			client := neo.NewClient("http://localhost:10332", neo.WithNetworkGeneration(neo.NEO3))
+
+			_, err := client.GetUnclaimed(testAccounts[0].publicAddress)
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, err)
+		})
+	})
+
+	t.Run("BuildClaimTransaction()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			unsigned, err := neo.BuildClaimTransaction(testAccounts[0].publicAddress, claims)
+			assert.NoError(t, err)
+			assert.Equal(
+				t,
+				"020002b5f8b46e950ba4ff40be7e4704ccc4d220f878535c5a30d26fe027dbd2c415c5000017403d11013612c8e6e449f25cf327acb82cc324766468c8aadbcda3c80ffd960100000001e72d286979ee6cb1b7e65dfddfb2e384100b8d148e7758de42e4168b71792c60400e2707000000003775292229eccdf904f16fff8e83e7cffdc0f0ce",
+				unsigned,
+			)
+
+			// the transaction decodes, once an (empty) list of witnesses is appended
+			node := newTestNode(map[string]testHandler{
+				"sendrawtransaction": testResult(true),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			transactionIDs, err := client.SendRawTransactions(context.Background(), []string{unsigned + "00"}, false)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"0x686ddffcc8b9b3f6e4afe24219efecac015d133d2af87eb5c7151b2708bde59e"}, transactionIDs)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			for description, testCase := range map[string]struct {
+				address string
+				claims  []models.ClaimableOutput
+			}{
+				"NoClaims":       {testAccounts[0].publicAddress, nil},
+				"InvalidAddress": {"ALq7AWrhAueN6mJNqk6FHJjnsEoPRytLdX", claims},
+				"InvalidTxID":    {testAccounts[0].publicAddress, []models.ClaimableOutput{{TransactionID: "0x1234", Unclaimed: 1}}},
+				"InvalidIndex":   {testAccounts[0].publicAddress, []models.ClaimableOutput{{TransactionID: testTransactions[0].hash, Index: 0x10000, Unclaimed: 1}}},
+				"Duplicate":      {testAccounts[0].publicAddress, []models.ClaimableOutput{claims[0], claims[0]}},
+				"NothingToClaim": {testAccounts[0].publicAddress, []models.ClaimableOutput{{TransactionID: testTransactions[0].hash}}},
+			} {
+				_, err := neo.BuildClaimTransaction(testCase.address, testCase.claims)
+				assert.Error(t, err, description)
+			}
+		})
+	})
+}
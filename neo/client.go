@@ -1,11 +1,15 @@
 package neo
 
 import (
+	"context"
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/lomocoin/neo-go-sdk/neo/models"
 	"github.com/lomocoin/neo-go-sdk/neo/models/response"
@@ -14,40 +18,127 @@ import (
 type (
 	// Client is the entrypoint for the package, it is used to carry out all actions.
 	Client struct {
-		Node     string
-		nodeURIs []string
+		Node               string
+		nodeURIs           []string
+		transactionCache   *transactionCache
+		maxRetries         int
+		retryDelay         time.Duration
+		retryClassifier    RetryClassifier
+		circuitBreakers    *circuitBreakers
+		walletCapability   *walletCapability
+		network            *networkDetection
+		selectionTolerance int64
+		nnsContract        string
+		policyContract     string
+		nodeChange         *nodeChangeNotifier
+		addressVersion     byte
+		httpClient         *http.Client
+		certificatePins    [][]byte
+		certPinning        bool
+		customHTTPClient   bool
+		timeout            time.Duration
+		methodTimeouts     map[string]time.Duration
+		attemptTimeout     time.Duration
+		responseHeaders    *responseHeaders
+		maxBatchSize       int
+		pings              *pingCache
+		assets             *assetCache
+		validators         *validatorCache
+		ctx                context.Context
 	}
 )
 
 // NewClient creates a new Client struct, with a single node URI.
-func NewClient(nodeURI string) Client {
-	return Client{
-		Node:     nodeURI,
-		nodeURIs: []string{nodeURI},
+func NewClient(nodeURI string, options ...Option) Client {
+	client := Client{
+		Node:       nodeURI,
+		nodeURIs:   []string{nodeURI},
+		network:    &networkDetection{},
+		pings:      &pingCache{},
+		assets:     &assetCache{},
+		validators: &validatorCache{},
+	}
+
+	for _, option := range options {
+		option(&client)
 	}
+
+	client.applyCertPinning()
+	client.nodeChange.notify(client.Node)
+	return client
 }
 
 // NewClientUsingMultipleNodes creates a new Client struct, and allows multiple node URIs
 // to be passed in. Before the Client struct is returned, each node is queried to determine
 // its block height. The node with the highest block count is chosen.
-func NewClientUsingMultipleNodes(nodeURIs []string) (*Client, error) {
+func NewClientUsingMultipleNodes(nodeURIs []string, options ...Option) (*Client, error) {
 	if len(nodeURIs) == 0 {
 		return nil, errors.New("Length of 'nodeURIs' argument must be greater than 0")
 	}
 
 	client := Client{
-		nodeURIs: nodeURIs,
+		nodeURIs:   nodeURIs,
+		network:    &networkDetection{},
+		pings:      &pingCache{},
+		assets:     &assetCache{},
+		validators: &validatorCache{},
 	}
 
+	for _, option := range options {
+		option(&client)
+	}
+
+	client.applyCertPinning()
 	client.SelectBestNode()
 	return &client, nil
 }
 
+// GetAccountState returns the global asset (NEO, GAS, etc.) balances of the address,
+// without the node's wallet. The address is checked locally before the node is called,
+// an error is returned when it is not a valid address.
+func (c Client) GetAccountState(address string) (*models.AccountState, error) {
+	if _, _, err := decodeAddress(address); err != nil {
+		return nil, err
+	}
+
+	requestBodyParams := []interface{}{
+		address,
+	}
+	var resp response.AccountState
+
+	err := c.executeRequest("getaccountstate", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// GetAssetState returns the metadata of the global asset, such as its names and the amount
+// issued. Global assets only exist on NEO2, so this is only supported by NEO2 nodes.
+func (c Client) GetAssetState(assetID string) (*models.AssetState, error) {
+	if err := c.checkNetworkGeneration(NEO2); err != nil {
+		return nil, err
+	}
+
+	requestBodyParams := []interface{}{
+		assetID,
+	}
+	var resp response.AssetState
+
+	err := c.executeRequest("getassetstate", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
 // GetBestBlockHash returns the hash of the best block in the chain.
 func (c Client) GetBestBlockHash() (string, error) {
 	var resp response.String
 
-	err := executeRequest("getbestblockhash", nil, c.Node, &resp)
+	err := c.executeRequest("getbestblockhash", nil, &resp)
 	if err != nil {
 		return "", err
 	}
@@ -55,6 +146,18 @@ func (c Client) GetBestBlockHash() (string, error) {
 	return resp.Result, nil
 }
 
+// GetBestBlockHeader returns the header of the best block in the chain. The best block
+// hash and the header are fetched with two calls, so if a new block arrives in between
+// the header is still that of the (now previous) block whose hash was returned.
+func (c Client) GetBestBlockHeader() (*models.BlockHeader, error) {
+	hash, err := c.GetBestBlockHash()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetBlockHeaderByHash(hash)
+}
+
 // GetBlockByHash returns the corresponding block information according to the specified
 // hash value.
 func (c Client) GetBlockByHash(hash string) (*models.Block, error) {
@@ -63,7 +166,7 @@ func (c Client) GetBlockByHash(hash string) (*models.Block, error) {
 	}
 	var resp response.Block
 
-	err := executeRequest("getblock", requestBodyParams, c.Node, &resp)
+	err := c.executeRequest("getblock", requestBodyParams, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +182,7 @@ func (c Client) GetBlockByIndex(index int64) (*models.Block, error) {
 	}
 	var resp response.Block
 
-	err := executeRequest("getblock", requestBodyParams, c.Node, &resp)
+	err := c.executeRequest("getblock", requestBodyParams, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +194,7 @@ func (c Client) GetBlockByIndex(index int64) (*models.Block, error) {
 func (c Client) GetBlockCount() (int64, error) {
 	var resp response.Integer
 
-	err := executeRequest("getblockcount", nil, c.Node, &resp)
+	err := c.executeRequest("getblockcount", nil, &resp)
 	if err != nil {
 		return 0, err
 	}
@@ -107,7 +210,61 @@ func (c Client) GetBlockHash(index int64) (string, error) {
 	}
 	var resp response.String
 
-	err := executeRequest("getblockhash", requestBodyParams, c.Node, &resp)
+	err := c.executeRequest("getblockhash", requestBodyParams, &resp)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Result, nil
+}
+
+// GetBlockHeaderByHash returns the corresponding block header information according to
+// the specified hash value.
+func (c Client) GetBlockHeaderByHash(hash string) (*models.BlockHeader, error) {
+	requestBodyParams := []interface{}{
+		hash, 1,
+	}
+	var resp response.BlockHeader
+
+	err := c.executeRequest("getblockheader", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// GetBlockHeaderByIndex returns the corresponding block header information according to
+// the specified index value.
+func (c Client) GetBlockHeaderByIndex(index int64) (*models.BlockHeader, error) {
+	requestBodyParams := []interface{}{
+		index, 1,
+	}
+	var resp response.BlockHeader
+
+	err := c.executeRequest("getblockheader", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// GetBlockSysFee returns the total system fee, in GAS, of the blocks from the genesis
+// block up to and including the block at index, as a decimal string to avoid rounding.
+// The system fee of a single block is the difference between the totals at its index and
+// at the index before it. It is only supported by NEO2 nodes.
+func (c Client) GetBlockSysFee(index int64) (string, error) {
+	if err := c.checkNetworkGeneration(NEO2); err != nil {
+		return "", err
+	}
+
+	requestBodyParams := []interface{}{
+		index,
+	}
+	var resp response.String
+
+	err := c.executeRequest("getblocksysfee", requestBodyParams, &resp)
 	if err != nil {
 		return "", err
 	}
@@ -119,7 +276,7 @@ func (c Client) GetBlockHash(index int64) (string, error) {
 func (c Client) GetConnectionCount() (int64, error) {
 	var resp response.Integer
 
-	err := executeRequest("getconnectioncount", nil, c.Node, &resp)
+	err := c.executeRequest("getconnectioncount", nil, &resp)
 	if err != nil {
 		return 0, err
 	}
@@ -127,6 +284,133 @@ func (c Client) GetConnectionCount() (int64, error) {
 	return resp.Result, nil
 }
 
+// GetContractState returns the smart contract deployed with the script hash, including
+// its script, which can be compared with a compiled contract. NEO3 nodes return contracts
+// in another shape, so this is only supported by NEO2 nodes.
+func (c Client) GetContractState(scriptHash string) (*models.ContractState, error) {
+	if err := c.checkNetworkGeneration(NEO2); err != nil {
+		return nil, err
+	}
+
+	requestBodyParams := []interface{}{
+		scriptHash,
+	}
+	var resp response.ContractState
+
+	err := c.executeRequest("getcontractstate", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// GetNEP17Balances returns the NEP-17 token balances of the address. NEP-17 replaced
+// NEP-5 in NEO3, so this is only supported by NEO3 nodes with the TokensTracker plugin
+// installed, use the NEP-5 methods for NEO2 nodes. ErrUnsupportedNetworkGeneration is
+// returned when the node is known to be a NEO2 node, see NetworkGeneration.
+func (c Client) GetNEP17Balances(address string) (*models.NEP17Balances, error) {
+	if err := c.checkNetworkGeneration(NEO3); err != nil {
+		return nil, err
+	}
+
+	requestBodyParams := []interface{}{
+		address,
+	}
+	var resp response.NEP17Balances
+
+	err := c.executeRequest("getnep17balances", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// GetNEP17Transfers returns the NEP-17 transfers sent and received by the address between
+// start and end. A zero start or end is left for the node to default, which is the last 7
+// days, when only end is given the transfers of the 7 days before it are returned. Like
+// GetNEP17Balances, this is only supported by NEO3 nodes.
+func (c Client) GetNEP17Transfers(address string, start, end time.Time) (*models.NEP17Transfers, error) {
+	if err := c.checkNetworkGeneration(NEO3); err != nil {
+		return nil, err
+	}
+
+	requestBodyParams := []interface{}{
+		address,
+	}
+	if start.IsZero() && !end.IsZero() {
+		// the node only takes an end after a start
+		start = end.Add(-nep5TransferWindow)
+	}
+
+	if !start.IsZero() {
+		requestBodyParams = append(requestBodyParams, start.UnixNano()/int64(time.Millisecond))
+
+		if !end.IsZero() {
+			requestBodyParams = append(requestBodyParams, end.UnixNano()/int64(time.Millisecond))
+		}
+	}
+	var resp response.NEP17Transfers
+
+	err := c.executeRequest("getnep17transfers", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// GetNEP5Balances returns the NEP-5 token balances of the address. Amounts are left in
+// the token's smallest unit, as the node returns them. This is only supported by NEO2
+// nodes with the RpcNep5Tracker plugin installed, use GetNEP17Balances for NEO3 nodes.
+func (c Client) GetNEP5Balances(address string) (*models.NEP5Balances, error) {
+	if err := c.checkNetworkGeneration(NEO2); err != nil {
+		return nil, err
+	}
+
+	requestBodyParams := []interface{}{
+		address,
+	}
+	var resp response.NEP5Balances
+
+	err := c.executeRequest("getnep5balances", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// GetPeers returns the peers of the node: those it is connected to, those it knows of
+// but is not connected to, and those it has marked as bad.
+func (c Client) GetPeers() (*models.Peers, error) {
+	var resp response.Peers
+
+	err := c.executeRequest("getpeers", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// GetRawTransactionHex returns the serialized transaction, hex encoded, for storing it
+// compactly or signing it again. Unlike GetTransaction the transaction is not decoded.
+func (c Client) GetRawTransactionHex(hash string) (string, error) {
+	requestBodyParams := []interface{}{
+		hash, 0,
+	}
+	var resp response.String
+
+	err := c.executeRequest("getrawtransaction", requestBodyParams, &resp)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Result, nil
+}
+
 // GetStorage takes a smart contract hash and a storage key, and returns the storage value
 // if available.
 func (c Client) GetStorage(scriptHash string, storageKey string) (string, error) {
@@ -135,7 +419,7 @@ func (c Client) GetStorage(scriptHash string, storageKey string) (string, error)
 	}
 	var resp response.String
 
-	err := executeRequest("getstorage", requestBodyParams, c.Node, &resp)
+	err := c.executeRequest("getstorage", requestBodyParams, &resp)
 	if err != nil {
 		return "", err
 	}
@@ -143,19 +427,75 @@ func (c Client) GetStorage(scriptHash string, storageKey string) (string, error)
 	return resp.Result, nil
 }
 
+// GetStorageExists is like GetStorage, but also returns whether the storage key exists,
+// so that a missing key is told apart from a key holding an empty value. The key is sent
+// hex encoded to NEO2 nodes and base64 encoded to NEO3 nodes, which also return the value
+// base64 encoded. NEO2 nodes return null for a missing key, while NEO3 nodes return an
+// "unknown storage item" error, neither is returned as an error. Other errors, such as
+// for an unknown contract, are returned as is.
+func (c Client) GetStorageExists(scriptHash string, storageKey string) (string, bool, error) {
+	generation, err := c.NetworkGeneration()
+	if err != nil {
+		return "", false, err
+	}
+
+	encodedKey := hex.EncodeToString([]byte(storageKey))
+	if generation == NEO3 {
+		encodedKey = base64.StdEncoding.EncodeToString([]byte(storageKey))
+	}
+
+	requestBodyParams := []interface{}{
+		scriptHash, encodedKey,
+	}
+	var resp response.NullableString
+
+	err = c.executeRequest("getstorage", requestBodyParams, &resp)
+	if rpcErr, ok := err.(RPCError); ok && rpcErr.Code == rpcErrorCodeUnknownStorageItem {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+
+	if resp.Result == nil {
+		return "", false, nil
+	}
+
+	return *resp.Result, true, nil
+}
+
 // GetTransaction returns the corresponding transaction information based on the
-// specified hash value.
+// specified hash value. When the Client was created with WithTransactionCache, confirmed
+// transactions are served from the cache, note that the Confirmations value of a cached
+// transaction is the value at the time it was cached.
+//
+// Confirmations and BlockTime are taken from the node's response, nodes which leave them
+// out for a confirmed transaction have them filled in from the header of its block. When
+// the header cannot be fetched they are left as 0.
 func (c Client) GetTransaction(hash string) (*models.Transaction, error) {
+	if c.transactionCache != nil {
+		if transaction, ok := c.transactionCache.get(hash); ok {
+			return transaction, nil
+		}
+	}
+
 	requestBodyParams := []interface{}{
 		hash, 1,
 	}
 	var resp response.Transaction
 
-	err := executeRequest("getrawtransaction", requestBodyParams, c.Node, &resp)
+	err := c.executeRequest("getrawtransaction", requestBodyParams, &resp)
 	if err != nil {
 		return nil, err
 	}
 
+	// the block details are extra information, so the transaction is still returned
+	// without them when they cannot be fetched, but it is not cached
+	err = c.fillBlockDetails(&resp.Result)
+	if err == nil && c.transactionCache != nil {
+		c.transactionCache.add(resp.Result)
+	}
+
 	return &resp.Result, nil
 }
 
@@ -167,7 +507,7 @@ func (c Client) GetTransactionOutput(hash string, index int64) (*models.Vout, er
 	}
 	var resp response.Vout
 
-	err := executeRequest("gettxout", requestBodyParams, c.Node, &resp)
+	err := c.executeRequest("gettxout", requestBodyParams, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -175,12 +515,31 @@ func (c Client) GetTransactionOutput(hash string, index int64) (*models.Vout, er
 	return &resp.Result, nil
 }
 
+// GetTransactionOutputsByAsset returns the outputs of the transaction which are of the
+// given asset, in output order. The asset ID may be in any of the formats accepted by
+// NormalizeAssetID, such as "GAS" or the ID with or without the 0x prefix.
+func (c Client) GetTransactionOutputsByAsset(txHash, assetID string) ([]models.Vout, error) {
+	transaction, err := c.GetTransaction(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := []models.Vout{}
+	for _, output := range transaction.Vout {
+		if AssetEquals(output.Asset, assetID) {
+			outputs = append(outputs, output)
+		}
+	}
+
+	return outputs, nil
+}
+
 // GetUnconfirmedTransactions returns a slice of transaction hashes that are all
 // unconfirmed transactions that the node has in memory.
 func (c Client) GetUnconfirmedTransactions() ([]string, error) {
 	var response response.StringArray
 
-	err := executeRequest("getrawmempool", nil, c.Node, &response)
+	err := c.executeRequest("getrawmempool", nil, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -188,48 +547,124 @@ func (c Client) GetUnconfirmedTransactions() ([]string, error) {
 	return response.Result, nil
 }
 
+// GetUnspents returns the unspent transaction outputs of the address, grouped by asset,
+// for selecting the inputs of a transaction without the node's wallet. Values are parsed
+// exactly, as Fixed8. The node must have the RpcSystemAssetTracker plugin installed.
+func (c Client) GetUnspents(address string) (*models.Unspents, error) {
+	requestBodyParams := []interface{}{
+		address,
+	}
+	var resp response.Unspents
+
+	err := c.executeRequest("getunspents", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	setUnspentsScriptHash(&resp.Result)
+	return &resp.Result, nil
+}
+
+// setUnspentsScriptHash sets the script hash of the unspents from their address, as the
+// node does not return it.
+func setUnspentsScriptHash(unspents *models.Unspents) {
+	if unspents.ScriptHash != "" {
+		return
+	}
+
+	if _, scriptHash, err := decodeAddress(unspents.Address); err == nil {
+		unspents.ScriptHash = "0x" + hex.EncodeToString(reverseBytes(scriptHash))
+	}
+}
+
+// GetValidators returns the validator candidates, with their votes and whether they are
+// currently elected. Nodes which do not have getvalidators (NEO3) are asked for
+// getcandidates instead, which has the same result.
+func (c Client) GetValidators() ([]models.Validator, error) {
+	var resp response.Validators
+
+	err := c.executeRequest("getvalidators", nil, &resp)
+	if rpcErr, ok := err.(RPCError); ok && rpcErr.Code == rpcErrorCodeMethodNotFound {
+		err = c.executeRequest("getcandidates", nil, &resp)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Result, nil
+}
+
+// GetVersion returns the version information of the node, such as its user agent.
+// Fields which the node does not report are left zero: NEO2 nodes report Port rather than
+// TCPPort and WSPort, and some nodes omit the nonce.
+func (c Client) GetVersion() (*models.Version, error) {
+	var resp response.Version
+
+	err := c.executeRequest("getversion", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
 // SelectBestNode selects the best node to use for RPC calls. If there is a single
-// node URI then that will be used. If there are 2 or more then each node is called
-// and the block count is compared. The node with the heighest block count is used.
+// node URI then that will be used. If there are 2 or more then each node is called,
+// concurrently, and the block count is compared. The node with the heighest block count is used.
+//
+// Ties are broken deterministically: of the nodes with the highest block count, the one
+// which comes first in the node URIs wins. When WithSelectionTolerance is used, nodes
+// within the tolerance of the highest block count also count as tied.
 func (c *Client) SelectBestNode() error {
 	if len(c.nodeURIs) == 1 {
 		c.Node = c.nodeURIs[0]
+		c.nodeChange.notify(c.Node)
 		return nil
 	}
 
-	var bestNode string
+	blockCounts, _ := c.probeBlockCounts(c.nodeURIs)
 	highestBlock := int64(0)
 
-	for _, nodeURI := range c.nodeURIs {
-		tempClient := NewClient(nodeURI)
-
-		blockCount, err := tempClient.GetBlockCount()
-		if err != nil {
-			continue
-		}
-
+	for _, blockCount := range blockCounts {
 		if blockCount > highestBlock {
 			highestBlock = blockCount
-			bestNode = nodeURI
 		}
 	}
 
-	if bestNode == "" {
+	if len(blockCounts) == 0 {
 		return fmt.Errorf("Unable to communicate with any nodes")
 	}
 
-	c.Node = bestNode
+	for _, nodeURI := range c.nodeURIs {
+		blockCount, ok := blockCounts[nodeURI]
+		if ok && blockCount >= highestBlock-c.selectionTolerance {
+			c.Node = nodeURI
+			break
+		}
+	}
+
+	c.nodeChange.notify(c.Node)
 	return nil
 }
 
-// Ping checks if the node is online.
+// Ping checks if the node is online, by opening a TCP connection to it. See PingCached
+// for a variant which reuses a recent outcome.
 func (c Client) Ping() bool {
+	ok := c.ping()
+	c.pings.set(c.Node, ok)
+
+	return ok
+}
+
+func (c Client) ping() bool {
 	parsedURI, err := url.Parse(c.Node)
 	if err != nil {
 		return false
 	}
 
-	conn, err := net.Dial("tcp", parsedURI.Host)
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(c.context(), "tcp", parsedURI.Host)
 	if err != nil {
 		return false
 	}
@@ -246,7 +681,7 @@ func (c Client) ValidateAddress(address string) (bool, error) {
 	}
 	var resp response.StringMap
 
-	err := executeRequest("validateaddress", requestBodyParams, c.Node, &resp)
+	err := c.executeRequest("validateaddress", requestBodyParams, &resp)
 	if err != nil {
 		return false, err
 	}
@@ -280,6 +715,10 @@ func (c Client) ValidateAddress(address string) (bool, error) {
 // GetBalance 根据指定的资产编号，返回钱包中对应资产的余额信息
 // 执行此命令前需要在 Neo-CLI 节点中打开钱包
 func (c Client) GetBalance(assetID string) (balance, confirmed string, err error) {
+	if err = c.checkWalletMethods(); err != nil {
+		return
+	}
+
 	requestBodyParams := []interface{}{
 		assetID,
 	}
@@ -292,7 +731,7 @@ func (c Client) GetBalance(assetID string) (balance, confirmed string, err error
 		Result jd `json:"result"`
 	}
 
-	err = executeRequest("getbalance", requestBodyParams, c.Node, &resp)
+	err = c.executeRequest("getbalance", requestBodyParams, &resp)
 	if err != nil {
 		return
 	}
@@ -303,12 +742,16 @@ func (c Client) GetBalance(assetID string) (balance, confirmed string, err error
 // GetNewAddress 创建一个新的地址
 // 执行此命令前需要在 Neo-CLI 节点中打开钱包
 func (c Client) GetNewAddress() (address string, err error) {
+	if err = c.checkWalletMethods(); err != nil {
+		return
+	}
+
 	var resp struct {
 		response.StringMap
 		Result string `json:"result"`
 	}
 
-	err = executeRequest("getnewaddress", nil, c.Node, &resp)
+	err = c.executeRequest("getnewaddress", nil, &resp)
 	if err != nil {
 		return
 	}
@@ -316,18 +759,83 @@ func (c Client) GetNewAddress() (address string, err error) {
 	return
 }
 
-// SendToAddress 向指定地址转账
+// SendToAddress 向指定地址转账，可通过 WithFee 和 WithChangeAddress 指定手续费和找零地址
+// 执行此命令前需要在 Neo-CLI 节点中打开钱包
+func (c Client) SendToAddress(assetID, toAddress string, amount interface{}, options ...SendOption) (txID string, err error) {
+	if err = c.checkWalletMethods(); err != nil {
+		return
+	}
+
+	requestBodyParams := []interface{}{
+		assetID,
+		toAddress,
+		amount,
+	}
+	requestBodyParams = append(requestBodyParams, newSendOptions(options).parameters()...)
+
+	var resp response.Transaction
+
+	err = c.executeRequest("sendtoaddress", requestBodyParams, &resp)
+	if err != nil {
+		return
+	}
+	txID = resp.Result.ID
+	return
+}
+
+// SendFrom 从钱包中的指定地址向指定地址转账，可通过 WithFee 和 WithChangeAddress 指定手续费和找零地址
+// fromAddress 余额不足时返回节点的错误信息
 // 执行此命令前需要在 Neo-CLI 节点中打开钱包
-func (c Client) SendToAddress(assetID, toAddress string, amount interface{}) (txID string, err error) {
+func (c Client) SendFrom(assetID, fromAddress, toAddress string, amount interface{}, options ...SendOption) (txID string, err error) {
+	if err = c.checkWalletMethods(); err != nil {
+		return
+	}
+
 	requestBodyParams := []interface{}{
 		assetID,
+		fromAddress,
 		toAddress,
 		amount,
 	}
+	requestBodyParams = append(requestBodyParams, newSendOptions(options).parameters()...)
+
+	var resp response.Transaction
+
+	err = c.executeRequest("sendfrom", requestBodyParams, &resp)
+	if err != nil {
+		return
+	}
+	txID = resp.Result.ID
+	return
+}
+
+// SendMany 在一笔交易中向多个地址转账，返回交易编号
+// 调用节点前会先检查 outputs 不为空且每个地址格式正确
+// 执行此命令前需要在 Neo-CLI 节点中打开钱包
+func (c Client) SendMany(outputs []models.TransferOutput) (txID string, err error) {
+	if len(outputs) == 0 {
+		err = errors.New("at least one output must be given")
+		return
+	}
+
+	for i, output := range outputs {
+		if _, _, addressErr := decodeAddress(output.Address); addressErr != nil {
+			err = fmt.Errorf("output %d has an invalid address: %s", i, addressErr)
+			return
+		}
+	}
+
+	if err = c.checkWalletMethods(); err != nil {
+		return
+	}
+
+	requestBodyParams := []interface{}{
+		outputs,
+	}
 
 	var resp response.Transaction
 
-	err = executeRequest("sendtoaddress", requestBodyParams, c.Node, &resp)
+	err = c.executeRequest("sendmany", requestBodyParams, &resp)
 	if err != nil {
 		return
 	}
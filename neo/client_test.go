@@ -1,9 +1,13 @@
 package neo_test
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,6 +27,124 @@ func TestClient(t *testing.T) {
 		assert.IsType(t, neo.Client{}, client)
 	})
 
+	t.Run(".GetAccountState()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getaccountstate": testRawResult(`{
+					"version": 0,
+					"script_hash": "0xcef0c0fdcfe7838eff6ff104f9cdec2922297537",
+					"frozen": false,
+					"votes": [],
+					"balances": [
+						{
+							"asset": "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b",
+							"value": "10"
+						},
+						{
+							"asset": "0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7",
+							"value": "0.00000123"
+						}
+					]
+				}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			state, err := client.GetAccountState(testAccounts[0].publicAddress)
+			assert.NoError(t, err)
+			assert.Equal(t, "0xcef0c0fdcfe7838eff6ff104f9cdec2922297537", state.ScriptHash)
+			assert.False(t, state.Frozen)
+			assert.Len(t, state.Balances, 2)
+			assert.Equal(t, models.NewFixed8(10), state.Balances[0].Value)
+			assert.Equal(t, "0.00000123", state.Balances[1].Value.String())
+		})
+
+		t.Run("InvalidAddress", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			for _, address := range []string{"", "not an address", "ALq7AWrhAueN6mJNqk6FHJjnsEoPRytLdX"} {
+				_, err := client.GetAccountState(address)
+				assert.Error(t, err, address)
+			}
+
+			assert.Empty(t, node.Calls("getaccountstate"))
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetAccountState(testAccounts[0].publicAddress)
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run(".GetAssetState()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getassetstate": testRawResult(`{
+					"version": 0,
+					"id": "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b",
+					"type": "GoverningToken",
+					"name": [
+						{"lang": "zh-CN", "name": "小蚁股"},
+						{"lang": "en", "name": "AntShare"}
+					],
+					"amount": "100000000",
+					"available": "100000000",
+					"precision": 0,
+					"owner": "00",
+					"admin": "Abf2qMs1pzQb8kYk9RuxtUb9jtRKJVuBJt",
+					"issuer": "Abf2qMs1pzQb8kYk9RuxtUb9jtRKJVuBJt",
+					"expiration": 4000000,
+					"frozen": false
+				}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			asset, err := client.GetAssetState(testTransactionOutputs[0].asset)
+			assert.NoError(t, err)
+			assert.Equal(t, "GoverningToken", asset.Type)
+			assert.Equal(t, []models.AssetName{
+				{Lang: "zh-CN", Name: "小蚁股"},
+				{Lang: "en", Name: "AntShare"},
+			}, asset.Name)
+			assert.Equal(t, "AntShare", asset.LocalizedName("en"))
+			assert.Equal(t, "小蚁股", asset.LocalizedName("fr"))
+			assert.Equal(t, models.NewFixed8(100000000), asset.Amount)
+			assert.Equal(t, models.NewFixed8(100000000), asset.Available)
+			assert.Equal(t, 0, asset.Precision)
+			assert.Equal(t, "Abf2qMs1pzQb8kYk9RuxtUb9jtRKJVuBJt", asset.Admin)
+			assert.Equal(t, int64(4000000), asset.Expiration)
+			assert.False(t, asset.Frozen)
+		})
+
+		t.Run("NEO3Node", func(t *testing.T) {
+			client := neo.NewClient("http://localhost:10332", neo.WithNetworkGeneration(neo.NEO3))
+
+			_, err := client.GetAssetState(testTransactionOutputs[0].asset)
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, err)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			_, err := client.GetAssetState(testTransactionOutputs[0].asset)
+			assert.Error(t, err)
+		})
+	})
+
 	t.Run(".GetBestBlockHash()", func(t *testing.T) {
 		t.Run("HappyCase", func(t *testing.T) {
 			client, err := neo.NewClientUsingMultipleNodes(nodes)
@@ -34,6 +156,29 @@ func TestClient(t *testing.T) {
 		})
 	})
 
+	t.Run(".GetBestBlockHeader()", func(t *testing.T) {
+		t.Run("Fixture", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getbestblockhash": testResult(testBlocks[0].hash),
+				"getblockheader":   testRawResult(testBlockJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			header, err := client.GetBestBlockHeader()
+			assert.NoError(t, err)
+			assert.Equal(t, testBlocks[0].hash, header.Hash)
+			assert.Equal(t, testBlocks[0].index, header.Index)
+			assert.Equal(t, int64(1506871433), header.Time)
+
+			calls := node.Calls("getblockheader")
+			assert.Len(t, calls, 1)
+			assert.Equal(t, `"`+testBlocks[0].hash+`"`, string(calls[0].Params[0]))
+			assert.Equal(t, `1`, string(calls[0].Params[1]))
+		})
+	})
+
 	t.Run(".GetBlockByHash()", func(t *testing.T) {
 		t.Run("HappyCase", func(t *testing.T) {
 			client, err := neo.NewClientUsingMultipleNodes(nodes)
@@ -51,6 +196,34 @@ func TestClient(t *testing.T) {
 				})
 			}
 		})
+
+		t.Run("Fixture", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblock": testRawResult(testBlockJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			block, err := client.GetBlockByHash(testBlocks[0].hash)
+			assert.NoError(t, err)
+			assert.Len(t, block.Transactions, 1)
+			assert.Equal(t, []models.Vout{
+				{
+					Address: "ALq7AWrhAueN6mJNqk6FHJjnsEoPRytLdW",
+					Asset:   "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b",
+					N:       0,
+					Value:   models.NewFixed8(2),
+				},
+				{
+					Address: "AN2SiiLndiLsX9sYyVYmn3LYyjgozfUnb4",
+					Asset:   "0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7",
+					N:       1,
+					Value:   models.Fixed8(150000000),
+				},
+			}, block.Transactions[0].Vout)
+			assert.Equal(t, models.Fixed8(100000), block.Transactions[0].NetFee)
+		})
 	})
 
 	t.Run(".GetBlockByIndex()", func(t *testing.T) {
@@ -100,6 +273,46 @@ func TestClient(t *testing.T) {
 		})
 	})
 
+	t.Run(".GetBlockSysFee()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblocksysfee": testResult("195500"),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			sysFee, err := client.GetBlockSysFee(1005434)
+			assert.NoError(t, err)
+			assert.Equal(t, "195500", sysFee)
+
+			calls := node.Calls("getblocksysfee")
+			assert.Len(t, calls, 1)
+			assert.Equal(t, "1005434", string(calls[0].Params[0]))
+		})
+
+		t.Run("NEO3Node", func(t *testing.T) {
+			client := neo.NewClient("http://localhost:10332", neo.WithNetworkGeneration(neo.NEO3))
+
+			_, err := client.GetBlockSysFee(1005434)
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, err)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblocksysfee": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -100, Message: "Invalid Height"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			_, err := client.GetBlockSysFee(99999999)
+			assert.Equal(t, neo.RPCError{Code: -100, Message: "Invalid Height"}, err)
+		})
+	})
+
 	t.Run(".GetConnectionCount()", func(t *testing.T) {
 		t.Run("HappyCase", func(t *testing.T) {
 			client, err := neo.NewClientUsingMultipleNodes(nodes)
@@ -111,6 +324,259 @@ func TestClient(t *testing.T) {
 		})
 	})
 
+	t.Run(".GetContractState()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getcontractstate": testRawResult(`{
+					"version": 0,
+					"hash": "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9",
+					"script": "5fc56b6c766b00527ac4",
+					"parameters": ["String", "Array"],
+					"returntype": "ByteArray",
+					"name": "RPX Sale",
+					"code_version": "1.0",
+					"author": "Red Pulse",
+					"email": "rpx@red-pulse.com",
+					"description": "RPX Token Sale",
+					"properties": {"storage": true, "dynamic_invoke": false, "payable": true}
+				}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			contract, err := client.GetContractState("0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9")
+			assert.NoError(t, err)
+			assert.Equal(t, &models.ContractState{
+				Hash:          "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9",
+				Script:        "5fc56b6c766b00527ac4",
+				ParameterList: []string{"String", "Array"},
+				ReturnType:    "ByteArray",
+				Name:          "RPX Sale",
+				CodeVersion:   "1.0",
+				Author:        "Red Pulse",
+				Email:         "rpx@red-pulse.com",
+				Description:   "RPX Token Sale",
+				Properties: models.ContractProperties{
+					Storage: true,
+					Payable: true,
+				},
+			}, contract)
+		})
+
+		t.Run("NEO3Node", func(t *testing.T) {
+			client := neo.NewClient("http://localhost:10332", neo.WithNetworkGeneration(neo.NEO3))
+
+			_, err := client.GetContractState("0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9")
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, err)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			_, err := client.GetContractState("0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9")
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run(".GetNEP17Balances()", func(t *testing.T) {
+		t.Run("Fixture", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getnep17balances": testRawResult(`{
+					"address": "NikhQp1aAD1YFCiwknhM5LQQebj4464bCJ",
+					"balance": [
+						{
+							"assethash": "0xd2a4cff31913016155e38e474a2c06d08be276cf",
+							"name": "GasToken",
+							"symbol": "GAS",
+							"decimals": "8",
+							"amount": "3000000100000000",
+							"lastupdatedblock": 3
+						}
+					]
+				}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			balances, err := client.GetNEP17Balances("NikhQp1aAD1YFCiwknhM5LQQebj4464bCJ")
+			assert.NoError(t, err)
+			assert.Equal(t, models.NEP17Balances{
+				Address: "NikhQp1aAD1YFCiwknhM5LQQebj4464bCJ",
+				Balances: []models.NEP17Balance{
+					{
+						AssetHash:        "0xd2a4cff31913016155e38e474a2c06d08be276cf",
+						Name:             "GasToken",
+						Symbol:           "GAS",
+						Decimals:         "8",
+						Amount:           "3000000100000000",
+						LastUpdatedBlock: 3,
+					},
+				},
+			}, *balances)
+		})
+	})
+
+	t.Run(".GetNEP17Transfers()", func(t *testing.T) {
+		transfersJSON := `{
+			"sent": [],
+			"received": [
+				{
+					"timestamp": 1612690013650,
+					"assethash": "0xd2a4cff31913016155e38e474a2c06d08be276cf",
+					"transferaddress": null,
+					"amount": "3000000100000000",
+					"blockindex": 3,
+					"transfernotifyindex": 0,
+					"txhash": "0x5f957b34b0ef5e53bd0c81c38fd0b2bd58b4e3bb2f6d45c8f8e5cce6b5a0a5c2"
+				}
+			],
+			"address": "NikhQp1aAD1YFCiwknhM5LQQebj4464bCJ"
+		}`
+
+		t.Run("Fixture", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getnep17transfers": testRawResult(transfersJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			transfers, err := client.GetNEP17Transfers(
+				"NikhQp1aAD1YFCiwknhM5LQQebj4464bCJ", time.Time{}, time.Time{},
+			)
+			assert.NoError(t, err)
+			assert.Empty(t, transfers.Sent)
+			assert.Len(t, transfers.Received, 1)
+			assert.Equal(t, int64(1612690013650), transfers.Received[0].Timestamp)
+			assert.Equal(t, "", transfers.Received[0].TransferAddress)
+			assert.Equal(t, "3000000100000000", transfers.Received[0].Amount)
+
+			calls := node.Calls("getnep17transfers")
+			assert.Len(t, calls[0].Params, 1)
+		})
+
+		t.Run("TimeRange", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getnep17transfers": testRawResult(transfersJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			start := time.Unix(1612690000, 0)
+			end := start.Add(time.Hour)
+
+			_, err := client.GetNEP17Transfers("NikhQp1aAD1YFCiwknhM5LQQebj4464bCJ", start, end)
+			assert.NoError(t, err)
+
+			params := node.Calls("getnep17transfers")[0].Params
+			assert.Len(t, params, 3)
+			assert.Equal(t, "1612690000000", string(params[1]))
+			assert.Equal(t, "1612693600000", string(params[2]))
+		})
+
+		t.Run("EndOnly", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getnep17transfers": testRawResult(transfersJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			end := time.Unix(1612690000, 0)
+
+			_, err := client.GetNEP17Transfers("NikhQp1aAD1YFCiwknhM5LQQebj4464bCJ", time.Time{}, end)
+			assert.NoError(t, err)
+
+			params := node.Calls("getnep17transfers")[0].Params
+			assert.Len(t, params, 3)
+			assert.Equal(t, "1612085200000", string(params[1]))
+			assert.Equal(t, "1612690000000", string(params[2]))
+		})
+	})
+
+	t.Run(".GetNEP5Balances()", func(t *testing.T) {
+		t.Run("Fixture", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getnep5balances": testRawResult(`{
+					"balance": [
+						{
+							"asset_hash": "ecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9",
+							"amount": "2050000000000000000",
+							"last_updated_block": 251604
+						}
+					],
+					"address": "` + testAccounts[0].publicAddress + `"
+				}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			balances, err := client.GetNEP5Balances(testAccounts[0].publicAddress)
+			assert.NoError(t, err)
+			assert.Equal(t, models.NEP5Balances{
+				Address: testAccounts[0].publicAddress,
+				Balances: []models.NEP5Balance{
+					{
+						AssetHash:        "ecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9",
+						Amount:           "2050000000000000000",
+						LastUpdatedBlock: 251604,
+					},
+				},
+			}, *balances)
+		})
+
+		t.Run("NEO3Node", func(t *testing.T) {
+			client := neo.NewClient("http://localhost:10332", neo.WithNetworkGeneration(neo.NEO3))
+
+			_, err := client.GetNEP5Balances("NikhQp1aAD1YFCiwknhM5LQQebj4464bCJ")
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, err)
+		})
+	})
+
+	t.Run(".GetPeers()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getpeers": testRawResult(`{
+					"unconnected": [{"address": "10.0.0.2", "port": 10333}],
+					"bad": [],
+					"connected": [
+						{"address": "::ffff:10.0.0.3", "port": 10333},
+						{"address": "::ffff:10.0.0.4", "port": 20333}
+					]
+				}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			peers, err := client.GetPeers()
+			assert.NoError(t, err)
+			assert.Equal(t, []models.Peer{
+				{Address: "::ffff:10.0.0.3", Port: 10333},
+				{Address: "::ffff:10.0.0.4", Port: 20333},
+			}, peers.Connected)
+			assert.Equal(t, []models.Peer{{Address: "10.0.0.2", Port: 10333}}, peers.Unconnected)
+			assert.Empty(t, peers.Bad)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetPeers()
+			assert.Error(t, err)
+		})
+	})
+
 	t.Run(".GetStorage()", func(t *testing.T) {
 		t.Run("HappyCase", func(t *testing.T) {
 			client, err := neo.NewClientUsingMultipleNodes(nodes)
@@ -126,6 +592,139 @@ func TestClient(t *testing.T) {
 		})
 	})
 
+	t.Run(".GetStorageExists()", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			handler     testHandler
+			value       string
+			exists      bool
+			err         bool
+		}{
+			{
+				description: "Value",
+				handler:     testResult("0072ef3e2597e201"),
+				value:       "0072ef3e2597e201",
+				exists:      true,
+			},
+			{
+				description: "EmptyValue",
+				handler:     testResult(""),
+				exists:      true,
+			},
+			{
+				description: "NullResult",
+				handler:     testResult(nil),
+			},
+			{
+				description: "UnknownContract",
+				handler: func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -100, Message: "Unknown contract"}
+				},
+				err: true,
+			},
+			{
+				description: "OtherError",
+				handler: func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -32602, Message: "Invalid params"}
+				},
+				err: true,
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.description, func(t *testing.T) {
+				node := newTestNode(map[string]testHandler{
+					"getstorage": testCase.handler,
+				})
+				defer node.Close()
+
+				client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+				value, exists, err := client.GetStorageExists("0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9", "totalSupply")
+				assert.Equal(t, testCase.value, value)
+				assert.Equal(t, testCase.exists, exists)
+				if testCase.err {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+
+				assert.Equal(t, `"746f74616c537570706c79"`, string(node.Calls("getstorage")[0].Params[1]))
+			})
+		}
+
+		t.Run("NEO3Node", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getstorage": func(params []json.RawMessage) (interface{}, *testRPCError) {
+					if string(params[1]) != `"dG90YWxTdXBwbHk="` {
+						return nil, &testRPCError{Code: -104, Message: "Unknown storage item"}
+					}
+
+					return "AHLvPiWX4gE=", nil
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO3))
+
+			value, exists, err := client.GetStorageExists("0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9", "totalSupply")
+			assert.NoError(t, err)
+			assert.True(t, exists)
+			assert.Equal(t, "AHLvPiWX4gE=", value)
+
+			value, exists, err = client.GetStorageExists("0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9", "decimals")
+			assert.NoError(t, err)
+			assert.False(t, exists)
+			assert.Equal(t, "", value)
+		})
+
+		t.Run("NetworkUndetected", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getstorage": testResult("0072ef3e2597e201"),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, _, err := client.GetStorageExists("0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9", "totalSupply")
+			assert.Error(t, err)
+			assert.Empty(t, node.Calls("getstorage"))
+		})
+	})
+
+	t.Run(".GetRawTransactionHex()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getrawtransaction": testResult("00001dac2b7c000000"),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			hexTx, err := client.GetRawTransactionHex("0xfb5bd72b2d6792d75dc2f1084ffa9e9f70ca85543c717a6b13d9959b452a57d6")
+			assert.NoError(t, err)
+			assert.Equal(t, "00001dac2b7c000000", hexTx)
+
+			calls := node.Calls("getrawtransaction")
+			assert.Len(t, calls, 1)
+			assert.Equal(t, "0", string(calls[0].Params[1]))
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getrawtransaction": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -100, Message: "Unknown transaction"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetRawTransactionHex("0xfb5bd72b2d6792d75dc2f1084ffa9e9f70ca85543c717a6b13d9959b452a57d6")
+			assert.Equal(t, neo.RPCError{Code: -100, Message: "Unknown transaction"}, err)
+		})
+	})
+
 	t.Run(".GetTransaction()", func(t *testing.T) {
 		t.Run("HappyCase", func(t *testing.T) {
 			client, err := neo.NewClientUsingMultipleNodes(nodes)
@@ -141,6 +740,174 @@ func TestClient(t *testing.T) {
 				})
 			}
 		})
+
+		t.Run("BlockDetails", func(t *testing.T) {
+			t.Run("ProvidedByNode", func(t *testing.T) {
+				node := newTestNode(map[string]testHandler{
+					"getrawtransaction": testRawResult(testInvocationTransactionJSON),
+				})
+				defer node.Close()
+
+				client := neo.NewClient(node.URL)
+
+				transaction, err := client.GetTransaction("0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea")
+				assert.NoError(t, err)
+				assert.Equal(t, 10, transaction.Confirmations)
+				assert.Equal(t, 1506871433, transaction.BlockTime)
+				assert.Empty(t, node.Calls("getblockheader"))
+			})
+
+			t.Run("OmittedByNode", func(t *testing.T) {
+				handlers := testChain(120, 1506871000, 15)
+				handlers["getrawtransaction"] = testResult(map[string]interface{}{
+					"txid":      "0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea",
+					"blockhash": testChainHash(111),
+				})
+
+				node := newTestNode(handlers)
+				defer node.Close()
+
+				client := neo.NewClient(node.URL)
+
+				transaction, err := client.GetTransaction("0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea")
+				assert.NoError(t, err)
+				assert.Equal(t, 10, transaction.Confirmations)
+				assert.Equal(t, 1506871000+111*15, transaction.BlockTime)
+			})
+
+			t.Run("OmittedByHeader", func(t *testing.T) {
+				node := newTestNode(map[string]testHandler{
+					"getrawtransaction": testResult(map[string]interface{}{
+						"txid":      "0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea",
+						"blockhash": testChainHash(111),
+					}),
+					"getblockheader": testResult(map[string]interface{}{
+						"hash":  testChainHash(111),
+						"index": 111,
+						"time":  1506871433,
+					}),
+					"getblockcount": testResult(121),
+				})
+				defer node.Close()
+
+				client := neo.NewClient(node.URL)
+
+				transaction, err := client.GetTransaction("0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea")
+				assert.NoError(t, err)
+				assert.Equal(t, 10, transaction.Confirmations)
+				assert.Equal(t, 1506871433, transaction.BlockTime)
+			})
+
+			t.Run("HeaderUnavailable", func(t *testing.T) {
+				node := newTestNode(map[string]testHandler{
+					"getrawtransaction": testResult(map[string]interface{}{
+						"txid":      "0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea",
+						"blockhash": testChainHash(111),
+					}),
+				})
+				defer node.Close()
+
+				client := neo.NewClient(node.URL, neo.WithTransactionCache(10))
+
+				for i := 0; i < 2; i++ {
+					transaction, err := client.GetTransaction("0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea")
+					assert.NoError(t, err)
+					assert.Equal(t, 0, transaction.Confirmations)
+					assert.Equal(t, 0, transaction.BlockTime)
+				}
+
+				assert.Len(t, node.Calls("getrawtransaction"), 2)
+			})
+
+			t.Run("Unconfirmed", func(t *testing.T) {
+				node := newTestNode(map[string]testHandler{
+					"getrawtransaction": testResult(map[string]interface{}{
+						"txid": "0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea",
+					}),
+				})
+				defer node.Close()
+
+				client := neo.NewClient(node.URL)
+
+				transaction, err := client.GetTransaction("0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea")
+				assert.NoError(t, err)
+				assert.Equal(t, 0, transaction.Confirmations)
+				assert.Empty(t, node.Calls("getblockheader"))
+			})
+		})
+
+		t.Run("ScriptAttribute", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getrawtransaction": testRawResult(testInvocationTransactionJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			transaction, err := client.GetTransaction("0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea")
+			assert.NoError(t, err)
+			assert.Len(t, transaction.Attributes, 1)
+
+			attribute := transaction.Attributes[0]
+			assert.Equal(t, models.TransactionAttributeUsageScript, attribute.Usage)
+
+			scriptHash, err := attribute.ScriptHash()
+			assert.NoError(t, err)
+
+			caller, err := neo.ScriptHashToAddress(scriptHash, neo.DefaultAddressVersion)
+			assert.NoError(t, err)
+			assert.Equal(t, testAccounts[0].publicAddress, caller)
+		})
+	})
+
+	t.Run(".GetTransactionOutputsByAsset()", func(t *testing.T) {
+		neoAsset := "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b"
+		gasAsset := "0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7"
+
+		node := newTestNode(map[string]testHandler{
+			"getrawtransaction": testRawResult(`{
+				"txid": "0xc515c4d2db27e06fd2305a5c5378f820d2c4cc04477ebe40ffa40b956eb4f8b5",
+				"type": "ContractTransaction",
+				"vout": [
+					{"n": 0, "asset": "` + neoAsset + `", "value": "2", "address": "ALq7AWrhAueN6mJNqk6FHJjnsEoPRytLdW"},
+					{"n": 1, "asset": "` + gasAsset + `", "value": "1.5", "address": "AN2SiiLndiLsX9sYyVYmn3LYyjgozfUnb4"},
+					{"n": 2, "asset": "` + neoAsset + `", "value": "8", "address": "AVzgMjviERgZSCVoerzaGYhZhKoecd9RXk"}
+				],
+				"confirmations": 10
+			}`),
+		})
+		defer node.Close()
+
+		client := neo.NewClient(node.URL)
+
+		t.Run("HappyCase", func(t *testing.T) {
+			outputs, err := client.GetTransactionOutputsByAsset(testTransactions[0].hash, neoAsset)
+			assert.NoError(t, err)
+			assert.Len(t, outputs, 2)
+			assert.Equal(t, 0, outputs[0].N)
+			assert.Equal(t, models.NewFixed8(2), outputs[0].Value)
+			assert.Equal(t, 2, outputs[1].N)
+			assert.Equal(t, models.NewFixed8(8), outputs[1].Value)
+
+			outputs, err = client.GetTransactionOutputsByAsset(testTransactions[0].hash, strings.ToUpper(gasAsset[2:]))
+			assert.NoError(t, err)
+			assert.Len(t, outputs, 1)
+			assert.Equal(t, "AN2SiiLndiLsX9sYyVYmn3LYyjgozfUnb4", outputs[0].Address)
+		})
+
+		t.Run("NoMatchingOutputs", func(t *testing.T) {
+			outputs, err := client.GetTransactionOutputsByAsset(testTransactions[0].hash, "0x01")
+			assert.NoError(t, err)
+			assert.Empty(t, outputs)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			offline := newTestNode(map[string]testHandler{})
+			defer offline.Close()
+
+			_, err := neo.NewClient(offline.URL).GetTransactionOutputsByAsset(testTransactions[0].hash, neoAsset)
+			assert.Error(t, err)
+		})
 	})
 
 	t.Run(".GetTransactionOutput()", func(t *testing.T) {
@@ -157,10 +924,28 @@ func TestClient(t *testing.T) {
 
 					assert.NoError(t, err)
 					assert.Equal(t, testTransactionOutput.asset, transactionOutput.Asset)
-					assert.Equal(t, testTransactionOutput.value, transactionOutput.Value)
+					assert.Equal(t, testTransactionOutput.value, transactionOutput.Value.String())
 				})
 			}
 		})
+
+		t.Run("Fixture", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"gettxout": testRawResult(testTransactionOutputJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			transactionOutput, err := client.GetTransactionOutput(testTransactions[0].hash, 1)
+			assert.NoError(t, err)
+			assert.Equal(t, models.Vout{
+				Address: "AVzgMjviERgZSCVoerzaGYhZhKoecd9RXk",
+				Asset:   "0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7",
+				N:       1,
+				Value:   models.Fixed8(123),
+			}, *transactionOutput)
+		})
 	})
 
 	t.Run(".GetUnconfirmedTransactions()", func(t *testing.T) {
@@ -173,6 +958,115 @@ func TestClient(t *testing.T) {
 		})
 	})
 
+	t.Run(".GetUnspents()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getunspents": testRawResult(`{
+					"balance": [
+						{
+							"unspent": [
+								{
+									"txid": "0x2d33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c74b",
+									"n": 1,
+									"value": "0.12345679"
+								},
+								{
+									"txid": "0xfb5bd72b2d6792d75dc2f1084ffa9e9f70ca85543c717a6b13d9959b452a57d6",
+									"n": 0,
+									"value": 90071992.54740993
+								}
+							],
+							"asset_hash": "602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7",
+							"asset": "GAS",
+							"asset_symbol": "GAS",
+							"amount": "90071992.66086672"
+						}
+					],
+					"address": "` + testAccounts[0].publicAddress + `"
+				}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			unspents, err := client.GetUnspents(testAccounts[0].publicAddress)
+			assert.NoError(t, err)
+			assert.Equal(t, testAccounts[0].publicAddress, unspents.Address)
+			assert.Equal(t, "0xcef0c0fdcfe7838eff6ff104f9cdec2922297537", unspents.ScriptHash)
+			assert.Len(t, unspents.Balances, 1)
+
+			balance := unspents.Balances[0]
+			assert.Equal(t, "602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7", balance.AssetHash)
+			assert.Equal(t, "GAS", balance.AssetSymbol)
+			assert.Equal(t, "90071992.66086672", balance.Amount.String())
+			assert.Equal(t, []models.UnspentEntry{
+				{
+					TransactionID: "0x2d33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c74b",
+					Index:         1,
+					Value:         models.Fixed8(12345679),
+				},
+				{
+					TransactionID: "0xfb5bd72b2d6792d75dc2f1084ffa9e9f70ca85543c717a6b13d9959b452a57d6",
+					Index:         0,
+					Value:         models.Fixed8(9007199254740993),
+				},
+			}, balance.Unspents)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetUnspents(testAccounts[0].publicAddress)
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run(".GetVersion()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": testRawResult(`{"tcpport": 10333, "wsport": 10334, "nonce": 1156529325, "useragent": "/Neo:3.0.3/"}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			version, err := client.GetVersion()
+			assert.NoError(t, err)
+			assert.Equal(t, &models.Version{
+				TCPPort:   10333,
+				WSPort:    10334,
+				Nonce:     1156529325,
+				UserAgent: "/Neo:3.0.3/",
+			}, version)
+		})
+
+		t.Run("OmittedFields", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": testRawResult(`{"port": 10333, "useragent": "/NEO:2.10.3/"}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			version, err := client.GetVersion()
+			assert.NoError(t, err)
+			assert.Equal(t, &models.Version{Port: 10333, UserAgent: "/NEO:2.10.3/"}, version)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetVersion()
+			assert.Error(t, err)
+		})
+	})
+
 	t.Run(".Ping()", func(t *testing.T) {
 		t.Run("HappyCase", func(t *testing.T) {
 			client, err := neo.NewClientUsingMultipleNodes(nodes)
@@ -218,4 +1112,164 @@ func TestClient(t *testing.T) {
 			})
 		})
 	})
+
+	t.Run(".SelectBestNode()", func(t *testing.T) {
+		newNodes := func(blockCounts ...int64) ([]*testNode, []string) {
+			var testNodes []*testNode
+			var nodeURIs []string
+
+			for _, blockCount := range blockCounts {
+				node := newTestNode(map[string]testHandler{
+					"getblockcount": testResult(blockCount),
+				})
+				testNodes = append(testNodes, node)
+				nodeURIs = append(nodeURIs, node.URL)
+			}
+
+			return testNodes, nodeURIs
+		}
+
+		closeNodes := func(testNodes []*testNode) {
+			for _, node := range testNodes {
+				node.Close()
+			}
+		}
+
+		t.Run("HighestBlockCount", func(t *testing.T) {
+			testNodes, nodeURIs := newNodes(100, 102, 101)
+			defer closeNodes(testNodes)
+
+			client, err := neo.NewClientUsingMultipleNodes(nodeURIs)
+			assert.NoError(t, err)
+			assert.Equal(t, nodeURIs[1], client.Node)
+		})
+
+		t.Run("TieFirstNodeWins", func(t *testing.T) {
+			testNodes, nodeURIs := newNodes(100, 102, 102, 102)
+			defer closeNodes(testNodes)
+
+			for i := 0; i < 5; i++ {
+				client, err := neo.NewClientUsingMultipleNodes(nodeURIs)
+				assert.NoError(t, err)
+				assert.Equal(t, nodeURIs[1], client.Node)
+			}
+		})
+
+		t.Run("WithSelectionTolerance", func(t *testing.T) {
+			testNodes, nodeURIs := newNodes(99, 101, 100, 102)
+			defer closeNodes(testNodes)
+
+			client, err := neo.NewClientUsingMultipleNodes(nodeURIs, neo.WithSelectionTolerance(2))
+			assert.NoError(t, err)
+			assert.Equal(t, nodeURIs[1], client.Node)
+
+			client, err = neo.NewClientUsingMultipleNodes(nodeURIs, neo.WithSelectionTolerance(0))
+			assert.NoError(t, err)
+			assert.Equal(t, nodeURIs[3], client.Node)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			client, err := neo.NewClientUsingMultipleNodes(
+				[]string{"http://localhost:1", "http://localhost:2"},
+			)
+			assert.NoError(t, err)
+			assert.Error(t, client.SelectBestNode())
+		})
+	})
+
+	t.Run(".SendToAddress()", func(t *testing.T) {
+		asset := "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b"
+		toAddress := testAccounts[0].publicAddress
+		changeAddress := testAccounts[1].publicAddress
+
+		testCases := []struct {
+			description string
+			options     []neo.SendOption
+			params      string
+		}{
+			{
+				description: "WithoutOptions",
+				params:      `["` + asset + `","` + toAddress + `","1"]`,
+			},
+			{
+				description: "WithFee",
+				options:     []neo.SendOption{neo.WithFee("0.001")},
+				params:      `["` + asset + `","` + toAddress + `","1","0.001"]`,
+			},
+			{
+				description: "WithChangeAddress",
+				options:     []neo.SendOption{neo.WithChangeAddress(changeAddress)},
+				params:      `["` + asset + `","` + toAddress + `","1",0,"` + changeAddress + `"]`,
+			},
+			{
+				description: "WithFeeAndChangeAddress",
+				options: []neo.SendOption{
+					neo.WithChangeAddress(changeAddress),
+					neo.WithFee("0.001"),
+				},
+				params: `["` + asset + `","` + toAddress + `","1","0.001","` + changeAddress + `"]`,
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.description, func(t *testing.T) {
+				node := newTestNode(map[string]testHandler{
+					"sendtoaddress": testRawResult(`{"txid": "0x01"}`),
+				})
+				defer node.Close()
+
+				client := neo.NewClient(node.URL)
+
+				txID, err := client.SendToAddress(asset, toAddress, "1", testCase.options...)
+				assert.NoError(t, err)
+				assert.Equal(t, "0x01", txID)
+
+				calls := node.Calls("sendtoaddress")
+				assert.Len(t, calls, 1)
+
+				params, err := json.Marshal(calls[0].Params)
+				assert.NoError(t, err)
+				assert.JSONEq(t, testCase.params, string(params))
+			})
+		}
+	})
+	t.Run(".SendFrom()", func(t *testing.T) {
+		asset := "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b"
+		fromAddress := testAccounts[0].publicAddress
+		toAddress := testAccounts[1].publicAddress
+
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendfrom": testRawResult(`{"txid": "0x01"}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			txID, err := client.SendFrom(asset, fromAddress, toAddress, "1", neo.WithFee("0.001"))
+			assert.NoError(t, err)
+			assert.Equal(t, "0x01", txID)
+
+			calls := node.Calls("sendfrom")
+			assert.Len(t, calls, 1)
+
+			params, err := json.Marshal(calls[0].Params)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `["`+asset+`","`+fromAddress+`","`+toAddress+`","1","0.001"]`, string(params))
+		})
+
+		t.Run("InsufficientFunds", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendfrom": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -300, Message: "Insufficient funds"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.SendFrom(asset, fromAddress, toAddress, "1")
+			assert.Equal(t, neo.RPCError{Code: -300, Message: "Insufficient funds"}, err)
+		})
+	})
 }
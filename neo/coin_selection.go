@@ -0,0 +1,53 @@
+package neo
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+// ErrInsufficientFunds is returned by SelectInputs when the unspent outputs do not add up
+// to the target amount.
+var ErrInsufficientFunds = errors.New("insufficient funds to cover the target amount")
+
+// SelectInputs chooses which of the unspent outputs to spend in order to pay
+// targetAmount, and returns them along with the change left over. All of the outputs are
+// expected to be of the same asset.
+//
+// If a single output matches the target amount exactly it is used on its own, so that no
+// change output is needed. Otherwise the largest outputs are picked first until the target
+// is covered, keeping the number of inputs (and so the size of the transaction) low.
+// ErrInsufficientFunds is returned if all of the outputs together cannot cover the target.
+func SelectInputs(unspents []models.UnspentEntry, targetAmount models.Fixed8) ([]models.UnspentEntry, models.Fixed8, error) {
+	if targetAmount <= 0 {
+		return nil, 0, fmt.Errorf("target amount must be greater than 0, got: %s", targetAmount)
+	}
+
+	for _, unspent := range unspents {
+		if unspent.Value == targetAmount {
+			return []models.UnspentEntry{unspent}, 0, nil
+		}
+	}
+
+	sorted := make([]models.UnspentEntry, len(unspents))
+	copy(sorted, unspents)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Value > sorted[j].Value
+	})
+
+	var selected []models.UnspentEntry
+	var total models.Fixed8
+
+	for _, unspent := range sorted {
+		selected = append(selected, unspent)
+		total += unspent.Value
+
+		if total >= targetAmount {
+			return selected, total - targetAmount, nil
+		}
+	}
+
+	return nil, 0, ErrInsufficientFunds
+}
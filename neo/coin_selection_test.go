@@ -0,0 +1,59 @@
+package neo_test
+
+import (
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoinSelection(t *testing.T) {
+	unspents := []models.UnspentEntry{
+		{TransactionID: "0x01", Index: 0, Value: models.NewFixed8(1)},
+		{TransactionID: "0x02", Index: 0, Value: models.NewFixed8(5)},
+		{TransactionID: "0x03", Index: 1, Value: models.NewFixed8(3)},
+		{TransactionID: "0x04", Index: 0, Value: models.NewFixed8(2)},
+	}
+
+	t.Run("SelectInputs()", func(t *testing.T) {
+		t.Run("ExactMatch", func(t *testing.T) {
+			selected, change, err := neo.SelectInputs(unspents, models.NewFixed8(3))
+			assert.NoError(t, err)
+			assert.Equal(t, []models.UnspentEntry{unspents[2]}, selected)
+			assert.Equal(t, models.Fixed8(0), change)
+		})
+
+		t.Run("Change", func(t *testing.T) {
+			selected, change, err := neo.SelectInputs(unspents, models.NewFixed8(7))
+			assert.NoError(t, err)
+			assert.Equal(t, []models.UnspentEntry{unspents[1], unspents[2]}, selected)
+			assert.Equal(t, models.NewFixed8(1), change)
+		})
+
+		t.Run("AllInputs", func(t *testing.T) {
+			selected, change, err := neo.SelectInputs(unspents, models.NewFixed8(11))
+			assert.NoError(t, err)
+			assert.Len(t, selected, 4)
+			assert.Equal(t, models.Fixed8(0), change)
+		})
+
+		t.Run("InsufficientFunds", func(t *testing.T) {
+			selected, change, err := neo.SelectInputs(unspents, models.NewFixed8(12))
+			assert.Equal(t, neo.ErrInsufficientFunds, err)
+			assert.Nil(t, selected)
+			assert.Equal(t, models.Fixed8(0), change)
+		})
+
+		t.Run("InvalidTarget", func(t *testing.T) {
+			_, _, err := neo.SelectInputs(unspents, 0)
+			assert.Error(t, err)
+		})
+
+		t.Run("DoesNotReorderInput", func(t *testing.T) {
+			_, _, err := neo.SelectInputs(unspents, models.NewFixed8(7))
+			assert.NoError(t, err)
+			assert.Equal(t, "0x01", unspents[0].TransactionID)
+		})
+	})
+}
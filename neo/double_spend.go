@@ -0,0 +1,144 @@
+package neo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+// conflictScanConcurrency is the number of calls in flight at a time while
+// FindConflictingSpends fetches mempool transactions and blocks.
+const conflictScanConcurrency = 4
+
+type (
+	// SpendConflict is another transaction which spends an input of the transaction
+	// passed to FindConflictingSpends. Confirmed is true, and BlockIndex is set, when the
+	// conflicting transaction is in a block, otherwise it is in the node's mempool.
+	SpendConflict struct {
+		Input         models.Vin
+		TransactionID string
+		Confirmed     bool
+		BlockIndex    int64
+	}
+)
+
+// FindConflictingSpends looks for other transactions which spend any of the inputs of the
+// transaction, in the node's mempool and in the last recentBlocks blocks, as a check for
+// a double spend before a deposit is credited. Conflicts in blocks are returned first,
+// in block order, followed by those in the mempool.
+//
+// Only the transactions the node has received can be checked: a conflicting transaction
+// which has not yet reached the node, is only in the mempool of other nodes (see
+// AggregatedMempool), or was confirmed before the scanned blocks is not found. An empty
+// result is therefore not proof that there is no double spend.
+//
+// If any mempool transactions cannot be fetched, such as because they were confirmed or
+// dropped in the meantime, a BatchError keyed by transaction ID is returned with the
+// conflicts that were found. When ctx is done no further calls are started, those in
+// flight are aborted, and ctx.Err() is returned.
+func (c Client) FindConflictingSpends(ctx context.Context, transaction *models.Transaction, recentBlocks int64) ([]SpendConflict, error) {
+	c = c.WithContext(ctx)
+
+	inputs := make(map[string]models.Vin, len(transaction.Vin))
+	for _, input := range transaction.Vin {
+		inputs[spentOutputKey(input)] = input
+	}
+
+	transactionID, _ := normalizeTxHash(transaction.ID)
+
+	// conflictsOf returns the inputs of the transaction which other spends
+	conflictsOf := func(other models.Transaction) []SpendConflict {
+		if otherID, _ := normalizeTxHash(other.ID); otherID == transactionID {
+			return nil
+		}
+
+		var conflicts []SpendConflict
+		for _, otherInput := range other.Vin {
+			if input, ok := inputs[spentOutputKey(otherInput)]; ok {
+				conflicts = append(conflicts, SpendConflict{
+					Input:         input,
+					TransactionID: other.ID,
+				})
+			}
+		}
+
+		return conflicts
+	}
+
+	var conflicts []SpendConflict
+
+	if recentBlocks > 0 {
+		blockCount, err := c.GetBlockCount()
+		if err != nil {
+			return nil, err
+		}
+
+		start := blockCount - recentBlocks
+		if start < 0 {
+			start = 0
+		}
+
+		blocks, err := c.GetBlocksInRange(ctx, start, blockCount-1, conflictScanConcurrency)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, block := range blocks {
+			for _, other := range block.Transactions {
+				for _, conflict := range conflictsOf(other) {
+					conflict.Confirmed = true
+					conflict.BlockIndex = block.Index
+					conflicts = append(conflicts, conflict)
+				}
+			}
+		}
+	}
+
+	hashes, err := c.GetUnconfirmedTransactions()
+	if err != nil {
+		return conflicts, err
+	}
+
+	var mutex sync.Mutex
+	mempoolConflicts := map[string][]SpendConflict{}
+
+	batchErr := runConcurrently(ctx, hashes, conflictScanConcurrency, func(hash string) error {
+		other, err := c.GetTransaction(hash)
+		if err != nil {
+			return err
+		}
+
+		mutex.Lock()
+		mempoolConflicts[hash] = conflictsOf(*other)
+		mutex.Unlock()
+
+		return nil
+	})
+
+	for _, hash := range hashes {
+		conflicts = append(conflicts, mempoolConflicts[hash]...)
+		delete(mempoolConflicts, hash)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return conflicts, err
+	}
+
+	if batchErr != nil {
+		return conflicts, batchErr
+	}
+
+	return conflicts, nil
+}
+
+// spentOutputKey identifies the output spent by the input.
+func spentOutputKey(input models.Vin) string {
+	transactionID, ok := normalizeTxHash(input.TransactionID)
+	if !ok {
+		transactionID = input.TransactionID
+	}
+
+	return fmt.Sprintf("%s:%d", transactionID, input.Vout)
+}
@@ -0,0 +1,134 @@
+package neo_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoubleSpend(t *testing.T) {
+	spentOutput := testTransactionOutputs[0].hash
+
+	transaction := &models.Transaction{
+		ID: testChainHash(100),
+		Vin: []models.Vin{
+			{TransactionID: spentOutput, Vout: 0},
+			{TransactionID: spentOutput, Vout: 1},
+		},
+	}
+
+	// other transactions, keyed by ID, and the output of spentOutput they spend
+	others := map[string]int{
+		testChainHash(101): 1,
+		testChainHash(102): 5,
+		testChainHash(103): 0,
+	}
+
+	transactionJSON := func(id string) map[string]interface{} {
+		if id == transaction.ID {
+			return map[string]interface{}{"txid": id, "vin": transaction.Vin}
+		}
+
+		return map[string]interface{}{
+			"txid": id,
+			"vin":  []models.Vin{{TransactionID: spentOutput, Vout: others[id]}},
+		}
+	}
+
+	newNode := func(mempool []string) *testNode {
+		return newTestNode(map[string]testHandler{
+			"getblockcount": testResult(3),
+			"getblock": func(params []json.RawMessage) (interface{}, *testRPCError) {
+				var index int64
+				_ = json.Unmarshal(params[0], &index)
+
+				block := map[string]interface{}{"index": index, "tx": []interface{}{}}
+				if index == 1 {
+					block["tx"] = []interface{}{transactionJSON(testChainHash(103))}
+				}
+
+				return block, nil
+			},
+			"getrawmempool": testResult(mempool),
+			"getrawtransaction": func(params []json.RawMessage) (interface{}, *testRPCError) {
+				var id string
+				_ = json.Unmarshal(params[0], &id)
+
+				if _, ok := others[id]; !ok && id != transaction.ID {
+					return nil, &testRPCError{Code: -100, Message: "Unknown transaction"}
+				}
+
+				return transactionJSON(id), nil
+			},
+		})
+	}
+
+	t.Run(".FindConflictingSpends()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newNode([]string{transaction.ID, testChainHash(101), testChainHash(102)})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			conflicts, err := client.FindConflictingSpends(context.Background(), transaction, 10)
+			assert.NoError(t, err)
+			assert.Equal(t, []neo.SpendConflict{
+				{
+					Input:         transaction.Vin[0],
+					TransactionID: testChainHash(103),
+					Confirmed:     true,
+					BlockIndex:    1,
+				},
+				{
+					Input:         transaction.Vin[1],
+					TransactionID: testChainHash(101),
+				},
+			}, conflicts)
+			assert.Len(t, node.Calls("getblock"), 3)
+		})
+
+		t.Run("NoConflicts", func(t *testing.T) {
+			node := newNode([]string{transaction.ID, testChainHash(102)})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			conflicts, err := client.FindConflictingSpends(context.Background(), transaction, 0)
+			assert.NoError(t, err)
+			assert.Empty(t, conflicts)
+			assert.Empty(t, node.Calls("getblock"))
+		})
+
+		t.Run("MempoolTransactionGone", func(t *testing.T) {
+			node := newNode([]string{testChainHash(101), testChainHash(104)})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			conflicts, err := client.FindConflictingSpends(context.Background(), transaction, 0)
+			assert.Len(t, conflicts, 1)
+
+			batchErr, ok := err.(neo.BatchError)
+			assert.True(t, ok)
+			assert.Error(t, batchErr[testChainHash(104)])
+		})
+
+		t.Run("CancelledContext", func(t *testing.T) {
+			node := newNode([]string{testChainHash(101)})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := client.FindConflictingSpends(ctx, transaction, 10)
+			assert.Error(t, err)
+			assert.Empty(t, node.Calls("getrawtransaction"))
+		})
+	})
+}
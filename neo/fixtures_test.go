@@ -104,4 +104,84 @@ var (
 			uri:         "/foo",
 		},
 	}
+
+	testTransactionOutputJSON = `{
+		"n": 1,
+		"asset": "0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7",
+		"value": "0.00000123",
+		"address": "AVzgMjviERgZSCVoerzaGYhZhKoecd9RXk"
+	}`
+
+	testBlockJSON = `{
+		"hash": "0x9ceb257832478ef778c1f26ad916ef8cbf116c71fe3cd6c5a8f672c1663539ae",
+		"size": 686,
+		"version": 0,
+		"previousblockhash": "0x2f2b8e38e8f9e6b3a5cbb1d1f3e58b2c60a96d2af2a9a2f6d04c0d3d1c3e7a4f",
+		"merkleroot": "0x04bb7e7c56711b3387f1593c36dcdc36516b6ccd06d0e0c15adeba3c33643ebe",
+		"time": 1506871433,
+		"index": 1511369,
+		"nonce": "5f6c5a2a74ec4b8b",
+		"nextconsensus": "APyEx5f4Zm4oCHwFWiSTaph1fPBxZacYVR",
+		"script": {
+			"invocation": "40",
+			"verification": "55"
+		},
+		"tx": [
+			{
+				"txid": "0xc515c4d2db27e06fd2305a5c5378f820d2c4cc04477ebe40ffa40b956eb4f8b5",
+				"size": 202,
+				"type": "ContractTransaction",
+				"version": 0,
+				"attributes": [],
+				"vin": [
+					{
+						"txid": "0x96fd0fc8a3cddbaac868647624c32cb8ac27f35cf249e4e6c8123601113d4017",
+						"vout": 0
+					}
+				],
+				"vout": [
+					{
+						"n": 0,
+						"asset": "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b",
+						"value": "2",
+						"address": "ALq7AWrhAueN6mJNqk6FHJjnsEoPRytLdW"
+					},
+					{
+						"n": 1,
+						"asset": "0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7",
+						"value": 1.5,
+						"address": "AN2SiiLndiLsX9sYyVYmn3LYyjgozfUnb4"
+					}
+				],
+				"sys_fee": "0",
+				"net_fee": "0.001",
+				"scripts": []
+			}
+		],
+		"confirmations": 10,
+		"nextblockhash": "0x6b5c3e22c7c1b0e6f50c1c46d1f5f1cd8e4a6f02b3a79c3df4f5d2e6c9a3e2b1"
+	}`
+
+	testInvocationTransactionJSON = `{
+		"txid": "0x0a7ca67e0c1c606863c7a170a397ec2b6d9851862b71c98ce27964f9d8ff00ea",
+		"size": 39,
+		"type": "InvocationTransaction",
+		"version": 1,
+		"attributes": [
+			{
+				"usage": "Script",
+				"data": "3775292229eccdf904f16fff8e83e7cffdc0f0ce"
+			}
+		],
+		"vin": [],
+		"vout": [],
+		"sys_fee": "0",
+		"net_fee": "0",
+		"scripts": [],
+		"script": "515293",
+		"gas": "0",
+		"blockhash": "0x9ceb257832478ef778c1f26ad916ef8cbf116c71fe3cd6c5a8f672c1663539ae",
+		"confirmations": 10,
+		"blocktime": 1506871433
+	}`
 )
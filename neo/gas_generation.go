@@ -0,0 +1,61 @@
+package neo
+
+import (
+	"fmt"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+const (
+	// neoTotalSupply is the number of NEO, which is indivisible, in existence.
+	neoTotalSupply = 100000000
+
+	// gasDecrementInterval is the number of blocks after which the amount of GAS
+	// generated per block decreases.
+	gasDecrementInterval = 2000000
+)
+
+// gasGenerationAmount is the amount of GAS generated per block, shared by all NEO
+// holders, in each gasDecrementInterval. No GAS is generated once the schedule ends.
+var gasGenerationAmount = []int64{8, 7, 6, 5, 4, 3, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+
+// EstimateGeneratedGAS returns the GAS generated, as a decimal string, by neoAmount NEO
+// held from startHeight up to, but not including, endHeight. It follows the NEO2 GAS
+// generation schedule, in which each NEO generates its share of 8 GAS per block, falling
+// by 1 GAS every 2 million blocks down to 1 GAS, until generation ends at block 44
+// million. The share of the system fees of the blocks, which is also claimable, is not
+// included.
+func EstimateGeneratedGAS(neoAmount int64, startHeight, endHeight int64) (string, error) {
+	if neoAmount < 0 || neoAmount > neoTotalSupply {
+		return "", fmt.Errorf("NEO amount must be between 0 and %d, got: %d", neoTotalSupply, neoAmount)
+	}
+
+	if startHeight < 0 {
+		return "", fmt.Errorf("start height must not be negative, got: %d", startHeight)
+	}
+
+	if endHeight < startHeight {
+		return "", fmt.Errorf("end height (%d) is before start height (%d)", endHeight, startHeight)
+	}
+
+	// amount is the GAS generated by all NEO over the range, each NEO generates
+	// 1/neoTotalSupply of it, which is amount Fixed8 units per NEO
+	amount := int64(0)
+
+	for height := startHeight; height < endHeight; {
+		period := height / gasDecrementInterval
+		if period >= int64(len(gasGenerationAmount)) {
+			break
+		}
+
+		periodEnd := (period + 1) * gasDecrementInterval
+		if periodEnd > endHeight {
+			periodEnd = endHeight
+		}
+
+		amount += (periodEnd - height) * gasGenerationAmount[period]
+		height = periodEnd
+	}
+
+	return models.Fixed8(neoAmount * amount).String(), nil
+}
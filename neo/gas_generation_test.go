@@ -0,0 +1,52 @@
+package neo_test
+
+import (
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGASGeneration(t *testing.T) {
+	t.Run("EstimateGeneratedGAS()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			testCases := []struct {
+				neoAmount   int64
+				startHeight int64
+				endHeight   int64
+				expected    string
+			}{
+				{1, 0, 1, "0.00000008"},
+				{100, 0, 2000000, "16"},
+				{1, 1999999, 2000001, "0.00000015"},
+				{10, 13999999, 14000001, "0.0000003"},
+				{50, 1000000, 5000000, "14"},
+				{100000000, 0, 44000000, "100000000"},
+				{1, 43999999, 50000000, "0.00000001"},
+				{1, 44000000, 50000000, "0"},
+				{1, 100, 100, "0"},
+				{0, 0, 44000000, "0"},
+			}
+
+			for _, testCase := range testCases {
+				gas, err := neo.EstimateGeneratedGAS(testCase.neoAmount, testCase.startHeight, testCase.endHeight)
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.expected, gas, "%+v", testCase)
+			}
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			_, err := neo.EstimateGeneratedGAS(-1, 0, 1)
+			assert.Error(t, err)
+
+			_, err = neo.EstimateGeneratedGAS(100000001, 0, 1)
+			assert.Error(t, err)
+
+			_, err = neo.EstimateGeneratedGAS(1, -1, 1)
+			assert.Error(t, err)
+
+			_, err = neo.EstimateGeneratedGAS(1, 10, 9)
+			assert.Error(t, err)
+		})
+	})
+}
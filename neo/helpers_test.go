@@ -0,0 +1,196 @@
+package neo_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+type (
+	// testHandler returns the result (or error) for a single JSON-RPC call made to a
+	// testNode.
+	testHandler func(params []json.RawMessage) (interface{}, *testRPCError)
+
+	testRPCError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+
+	testCall struct {
+		ID     int64             `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+
+	// testNode is a local JSON-RPC server which stands in for a NEO node, each method is
+	// answered by the matching handler and every call is recorded. JSON-RPC batches are
+	// answered call by call, and the size of each is recorded.
+	testNode struct {
+		*httptest.Server
+		mutex      sync.Mutex
+		calls      []testCall
+		batchSizes []int
+		handlers   map[string]testHandler
+	}
+)
+
+func newTestNode(handlers map[string]testHandler) *testNode {
+	node := &testNode{
+		handlers: handlers,
+	}
+	node.Server = httptest.NewServer(http.HandlerFunc(node.serveHTTP))
+
+	return node
+}
+
+// newTLSTestNode returns a testNode which is served over HTTPS, using a self-signed
+// certificate.
+func newTLSTestNode(handlers map[string]testHandler) *testNode {
+	node := &testNode{
+		handlers: handlers,
+	}
+	node.Server = httptest.NewTLSServer(http.HandlerFunc(node.serveHTTP))
+
+	return node
+}
+
+// Calls returns the calls made to the node for the given method.
+func (n *testNode) Calls(method string) []testCall {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	var calls []testCall
+	for _, call := range n.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// BatchSizes returns the number of calls in each JSON-RPC batch sent to the node.
+func (n *testNode) BatchSizes() []int {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	return append([]int(nil), n.batchSizes...)
+}
+
+func (n *testNode) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var batch []testCall
+	if err := json.Unmarshal(body, &batch); err == nil {
+		n.mutex.Lock()
+		n.batchSizes = append(n.batchSizes, len(batch))
+		n.mutex.Unlock()
+
+		resps := make([]map[string]interface{}, len(batch))
+		for i, call := range batch {
+			resps[i] = n.answer(call)
+		}
+
+		_ = json.NewEncoder(w).Encode(resps)
+		return
+	}
+
+	var call testCall
+	if err := json.Unmarshal(body, &call); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(n.answer(call))
+}
+
+// answer records the call and returns the response to it.
+func (n *testNode) answer(call testCall) map[string]interface{} {
+	n.mutex.Lock()
+	n.calls = append(n.calls, call)
+	handler, ok := n.handlers[call.Method]
+	n.mutex.Unlock()
+
+	resp := map[string]interface{}{
+		"id":      call.ID,
+		"jsonrpc": "2.0",
+	}
+
+	if !ok {
+		resp["error"] = testRPCError{Code: -32601, Message: "Method not found"}
+	} else if result, rpcErr := handler(call.Params); rpcErr != nil {
+		resp["error"] = rpcErr
+	} else {
+		resp["result"] = result
+	}
+
+	return resp
+}
+
+// testResult returns a testHandler which always answers with the given result.
+func testResult(result interface{}) testHandler {
+	return func([]json.RawMessage) (interface{}, *testRPCError) {
+		return result, nil
+	}
+}
+
+// testRawResult returns a testHandler which always answers with the given JSON document.
+func testRawResult(result string) testHandler {
+	return testResult(json.RawMessage(result))
+}
+
+// testChain returns handlers for a node whose chain has blocks 0 to height, block i has
+// the hash testChainHash(i) and was produced at startTime + i*interval (in seconds).
+func testChain(height, startTime, interval int64) map[string]testHandler {
+	header := func(index int64) interface{} {
+		header := map[string]interface{}{
+			"hash":          testChainHash(index),
+			"index":         index,
+			"time":          startTime + index*interval,
+			"confirmations": height - index + 1,
+		}
+
+		if index > 0 {
+			header["previousblockhash"] = testChainHash(index - 1)
+		}
+
+		return header
+	}
+
+	return map[string]testHandler{
+		"getbestblockhash": testResult(testChainHash(height)),
+		"getblockcount":    testResult(height + 1),
+		"getblockhash": func(params []json.RawMessage) (interface{}, *testRPCError) {
+			var index int64
+			_ = json.Unmarshal(params[0], &index)
+
+			return testChainHash(index), nil
+		},
+		"getblockheader": func(params []json.RawMessage) (interface{}, *testRPCError) {
+			var index int64
+			if err := json.Unmarshal(params[0], &index); err != nil {
+				var hash string
+				_ = json.Unmarshal(params[0], &hash)
+				_, _ = fmt.Sscanf(hash, "0x%x", &index)
+			}
+
+			if index < 0 || index > height {
+				return nil, &testRPCError{Code: -100, Message: "Unknown block"}
+			}
+
+			return header(index), nil
+		},
+	}
+}
+
+// testChainHash returns the hash of block index of a testChain.
+func testChainHash(index int64) string {
+	return fmt.Sprintf("0x%064x", index)
+}
@@ -0,0 +1,86 @@
+package neo
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/lomocoin/neo-go-sdk/neo/models/response"
+)
+
+// InvokeFunction test invokes the operation of the smart contract with the parameters,
+// nothing is persisted on the chain. The Encoding of the stack items is set from the
+// node's network generation when it is known.
+//
+// On NEO3 nodes, signers can be given so that CheckWitness passes for their accounts
+// within the scope of each signer, without them many contract methods FAULT under test
+// invocation. Signers are not supported by NEO2 nodes, for which
+// ErrUnsupportedNetworkGeneration is returned when they are given.
+func (c Client) InvokeFunction(scriptHash, operation string, parameters []models.Parameter, signers ...models.Signer) (*models.InvokeResult, error) {
+	if parameters == nil {
+		parameters = []models.Parameter{}
+	}
+
+	requestBodyParams := []interface{}{
+		scriptHash, operation, parameters,
+	}
+
+	if len(signers) > 0 {
+		if err := c.checkNetworkGeneration(NEO3); err != nil {
+			return nil, err
+		}
+
+		requestBodyParams = append(requestBodyParams, signers)
+	}
+
+	return c.invoke("invokefunction", requestBodyParams)
+}
+
+// InvokeScript test invokes the script, given as hex, in the same way as InvokeFunction,
+// such as to check a script built by the caller before it is sent in a transaction. NEO3
+// nodes take the script base64 encoded, it is converted when the node is known to be a
+// NEO3 node. Signers are only supported by NEO3 nodes, as with InvokeFunction.
+func (c Client) InvokeScript(script string, signers ...models.Signer) (*models.InvokeResult, error) {
+	scriptBytes, err := hex.DecodeString(strings.TrimPrefix(script, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	requestBodyParams := []interface{}{
+		hex.EncodeToString(scriptBytes),
+	}
+
+	if generation, err := c.NetworkGeneration(); err == nil && generation == NEO3 {
+		requestBodyParams[0] = base64.StdEncoding.EncodeToString(scriptBytes)
+	}
+
+	if len(signers) > 0 {
+		if err := c.checkNetworkGeneration(NEO3); err != nil {
+			return nil, err
+		}
+
+		requestBodyParams = append(requestBodyParams, signers)
+	}
+
+	return c.invoke("invokescript", requestBodyParams)
+}
+
+// invoke calls the invocation method, and sets the Encoding of the stack items of the
+// result from the node's network generation when it is known.
+func (c Client) invoke(method string, requestBodyParams []interface{}) (*models.InvokeResult, error) {
+	var resp response.InvokeResult
+
+	err := c.executeRequest(method, requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if generation, err := c.NetworkGeneration(); err == nil {
+		for i := range resp.Result.Stack {
+			resp.Result.Stack[i].Encoding = generation.StackEncoding()
+		}
+	}
+
+	return &resp.Result, nil
+}
@@ -0,0 +1,168 @@
+package neo_test
+
+import (
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvoke(t *testing.T) {
+	neo3Version := testRawResult(`{"tcpport": 10333, "useragent": "/Neo:3.0.3/"}`)
+	contract := "0xef4073a0f2b305a38ec4050e4d3d28bc40ea63f5"
+	account := "0xcef0c0fdcfe7838eff6ff104f9cdec2922297537"
+
+	invokeResult := testRawResult(`{
+		"state": "HALT",
+		"gasconsumed": "2028330",
+		"exception": null,
+		"stack": [{"type": "Integer", "value": "100"}]
+	}`)
+
+	t.Run(".InvokeFunction()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":     neo3Version,
+				"invokefunction": invokeResult,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			result, err := client.InvokeFunction(contract, "balanceOf", []models.Parameter{
+				{Type: models.ParameterTypeHash160, Value: account},
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, "HALT", result.State)
+			assert.Equal(t, "0.0202833", result.GasConsumed)
+
+			balance, err := result.Stack[0].AsInteger()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100), balance.Int64())
+
+			params := node.Calls("invokefunction")[0].Params
+			assert.Len(t, params, 3)
+			assert.JSONEq(t, `[{"type": "Hash160", "value": "`+account+`"}]`, string(params[2]))
+		})
+
+		t.Run("WithSigners", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":     neo3Version,
+				"invokefunction": invokeResult,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.InvokeFunction(contract, "balanceOf", []models.Parameter{
+				{Type: models.ParameterTypeHash160, Value: account},
+			}, models.Signer{
+				Account: account,
+				Scopes:  models.WitnessScopeCalledByEntry,
+			})
+			assert.NoError(t, err)
+
+			params := node.Calls("invokefunction")[0].Params
+			assert.Len(t, params, 4)
+			assert.JSONEq(t, `[{"account": "`+account+`", "scopes": "CalledByEntry"}]`, string(params[3]))
+		})
+
+		t.Run("NEO2Node", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":     testRawResult(`{"port": 10333, "useragent": "/NEO:2.10.3/"}`),
+				"invokefunction": invokeResult,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.InvokeFunction(contract, "balanceOf", nil, models.Signer{
+				Account: account,
+				Scopes:  models.WitnessScopeCalledByEntry,
+			})
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, err)
+			assert.Empty(t, node.Calls("invokefunction"))
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.InvokeFunction(contract, "balanceOf", nil)
+			assert.Error(t, err)
+		})
+	})
+	t.Run(".InvokeScript()", func(t *testing.T) {
+		// PUSH1 PUSH2 ADD
+		script := "515293"
+
+		t.Run("NEO2Node", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": testRawResult(`{"port": 10333, "useragent": "/NEO:2.10.3/"}`),
+				"invokescript": testRawResult(`{
+					"script": "515293",
+					"state": "HALT",
+					"gas_consumed": "0.03",
+					"stack": [{"type": "Integer", "value": "3"}]
+				}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			result, err := client.InvokeScript(script)
+			assert.NoError(t, err)
+			assert.Equal(t, "HALT", result.State)
+			assert.Equal(t, "0.03", result.GasConsumed)
+
+			sum, err := result.Stack[0].AsInteger()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(3), sum.Int64())
+			assert.Equal(t, models.ByteEncodingHex, result.Stack[0].Encoding)
+
+			params := node.Calls("invokescript")[0].Params
+			assert.Len(t, params, 1)
+			assert.JSONEq(t, `"515293"`, string(params[0]))
+		})
+
+		t.Run("NEO3Node", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":   neo3Version,
+				"invokescript": invokeResult,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			result, err := client.InvokeScript("0x"+script, models.Signer{
+				Account: account,
+				Scopes:  models.WitnessScopeCalledByEntry,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, models.ByteEncodingBase64, result.Stack[0].Encoding)
+
+			params := node.Calls("invokescript")[0].Params
+			assert.Len(t, params, 2)
+			assert.JSONEq(t, `"UVKT"`, string(params[0]))
+			assert.JSONEq(t, `[{"account": "`+account+`", "scopes": "CalledByEntry"}]`, string(params[1]))
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": neo3Version,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.InvokeScript("not hex")
+			assert.Error(t, err)
+
+			_, err = client.InvokeScript(script)
+			assert.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,101 @@
+package neo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/lomocoin/neo-go-sdk/neo/models/response"
+)
+
+// iteratorPageSize is the number of items requested from an iterator at once, which is
+// the default limit of NEO3 nodes.
+const iteratorPageSize = 100
+
+var (
+	// ErrIteratorSessionsUnavailable is returned by InvokeFunctionIterator when the node
+	// does not have sessions enabled, so iterators cannot be traversed.
+	ErrIteratorSessionsUnavailable = errors.New("node does not have iterator sessions enabled")
+
+	// ErrNoIterator is returned by InvokeFunctionIterator when the invocation does not
+	// return an iterator.
+	ErrNoIterator = errors.New("invocation did not return an iterator")
+)
+
+// InvokeFunctionIterator test invokes the operation of the smart contract, in the same way
+// as InvokeFunction, for operations which return an iterator, such as the tokens of an
+// owner. The iterator is traversed with traverseiterator until it is exhausted, and all of
+// its items are returned. The session opened by the invocation is then terminated.
+//
+// Iterators are only returned by NEO3 nodes, which must have sessions enabled (the
+// SessionEnabled setting of the RpcServer plugin), or ErrIteratorSessionsUnavailable is
+// returned. Sessions only exist on the node which opened them, so the whole session is
+// run on the Client's node, even with WithCircuitBreaker.
+func (c Client) InvokeFunctionIterator(scriptHash, operation string, parameters []models.Parameter, signers []models.Signer) ([]models.StackItem, error) {
+	if err := c.checkNetworkGeneration(NEO3); err != nil {
+		return nil, err
+	}
+
+	c = c.forNode(c.Node)
+
+	result, err := c.InvokeFunction(scriptHash, operation, parameters, signers...)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Session != "" {
+		defer c.terminateSession(result.Session)
+	}
+
+	if strings.Contains(result.State, "FAULT") {
+		return nil, fmt.Errorf("invocation of %s failed: %s", operation, result.Exception)
+	}
+
+	var iterator *models.StackItem
+	for i, item := range result.Stack {
+		if item.Type == "InteropInterface" && item.Interface == "IIterator" {
+			iterator = &result.Stack[i]
+			break
+		}
+	}
+
+	if iterator == nil {
+		return nil, ErrNoIterator
+	}
+
+	if result.Session == "" || iterator.ID == "" {
+		return nil, ErrIteratorSessionsUnavailable
+	}
+
+	items := []models.StackItem{}
+
+	for {
+		requestBodyParams := []interface{}{
+			result.Session, iterator.ID, iteratorPageSize,
+		}
+		var resp response.StackItems
+
+		err := c.executeRequest("traverseiterator", requestBodyParams, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(resp.Result) == 0 {
+			return items, nil
+		}
+
+		for _, item := range resp.Result {
+			item.Encoding = models.ByteEncodingBase64
+			items = append(items, item)
+		}
+	}
+}
+
+// terminateSession ends the iterator session, releasing it on the node before it expires.
+// Errors are ignored, as the session expires anyway.
+func (c Client) terminateSession(session string) {
+	var resp response.Boolean
+
+	_ = c.executeRequest("terminatesession", []interface{}{session}, &resp)
+}
@@ -0,0 +1,169 @@
+package neo_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterator(t *testing.T) {
+	contract := "0x50ac1c37690cc2cfc594472833cf57505d5f46de"
+	session := "6ecb8d7d-8d5f-4a52-ac9f-2e1ae5ab1e45"
+	iteratorID := "fcf7b800-192a-488f-95d3-c40ac7b10b5f"
+
+	// newIteratorNode returns a node whose tokensOf method returns an iterator over count
+	// tokens, which are traversed pageSize at a time.
+	newIteratorNode := func(count int, invokeResult string) *testNode {
+		var traversed int
+
+		return newTestNode(map[string]testHandler{
+			"invokefunction": testRawResult(invokeResult),
+			"traverseiterator": func(params []json.RawMessage) (interface{}, *testRPCError) {
+				var pageSize int
+				_ = json.Unmarshal(params[2], &pageSize)
+
+				items := []map[string]string{}
+				for ; traversed < count && len(items) < pageSize; traversed++ {
+					items = append(items, map[string]string{
+						"type":  "ByteString",
+						"value": base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("token%d", traversed))),
+					})
+				}
+
+				return items, nil
+			},
+			"terminatesession": testResult(true),
+		})
+	}
+
+	iteratorResult := `{
+		"script": "wh8MCXRva2Vuc09mDBTeRl9dUFfPlMXPDJw3HAxQQWJ9QQ==",
+		"state": "HALT",
+		"gasconsumed": "1011960",
+		"exception": null,
+		"stack": [{"type": "InteropInterface", "interface": "IIterator", "id": "` + iteratorID + `"}],
+		"session": "` + session + `"
+	}`
+
+	t.Run(".InvokeFunctionIterator()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newIteratorNode(150, iteratorResult)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO3))
+
+			items, err := client.InvokeFunctionIterator(contract, "tokensOf", []models.Parameter{
+				{Type: models.ParameterTypeHash160, Value: "0xcef0c0fdcfe7838eff6ff104f9cdec2922297537"},
+			}, nil)
+			assert.NoError(t, err)
+			assert.Len(t, items, 150)
+
+			token, err := items[149].AsString()
+			assert.NoError(t, err)
+			assert.Equal(t, "token149", token)
+
+			calls := node.Calls("traverseiterator")
+			assert.Len(t, calls, 3)
+			assert.Equal(t, `"`+session+`"`, string(calls[0].Params[0]))
+			assert.Equal(t, `"`+iteratorID+`"`, string(calls[0].Params[1]))
+
+			terminated := node.Calls("terminatesession")
+			assert.Len(t, terminated, 1)
+			assert.Equal(t, `"`+session+`"`, string(terminated[0].Params[0]))
+		})
+
+		t.Run("CircuitBreaker", func(t *testing.T) {
+			// the node opens the session, but fails once it is traversed
+			node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var request struct {
+					Method string `json:"method"`
+				}
+				_ = json.NewDecoder(r.Body).Decode(&request)
+
+				if request.Method != "invokefunction" {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+
+				_, _ = w.Write([]byte(`{"id": 1, "jsonrpc": "2.0", "result": ` + iteratorResult + `}`))
+			}))
+			defer node.Close()
+
+			otherNode := newIteratorNode(150, iteratorResult)
+			defer otherNode.Close()
+
+			client, err := neo.NewClientUsingMultipleNodes(
+				[]string{node.URL, otherNode.URL},
+				neo.WithNetworkGeneration(neo.NEO3),
+				neo.WithCircuitBreaker(5, time.Minute),
+			)
+			assert.NoError(t, err)
+			client.Node = node.URL
+
+			_, err = client.InvokeFunctionIterator(contract, "tokensOf", nil, nil)
+			assert.Error(t, err)
+			assert.Empty(t, otherNode.Calls("traverseiterator"))
+			assert.Empty(t, otherNode.Calls("terminatesession"))
+		})
+
+		t.Run("NoIterator", func(t *testing.T) {
+			node := newIteratorNode(0, `{
+				"state": "HALT",
+				"stack": [{"type": "Integer", "value": "1"}],
+				"session": "`+session+`"
+			}`)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO3))
+
+			_, err := client.InvokeFunctionIterator(contract, "balanceOf", nil, nil)
+			assert.Equal(t, neo.ErrNoIterator, err)
+			assert.Len(t, node.Calls("terminatesession"), 1)
+		})
+
+		t.Run("SessionsDisabled", func(t *testing.T) {
+			node := newIteratorNode(0, `{
+				"state": "HALT",
+				"stack": [{"type": "InteropInterface", "interface": "IIterator"}]
+			}`)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO3))
+
+			_, err := client.InvokeFunctionIterator(contract, "tokensOf", nil, nil)
+			assert.Equal(t, neo.ErrIteratorSessionsUnavailable, err)
+			assert.Empty(t, node.Calls("traverseiterator"))
+		})
+
+		t.Run("Fault", func(t *testing.T) {
+			node := newIteratorNode(0, `{
+				"state": "FAULT",
+				"exception": "method not found: tokensOf/1",
+				"stack": [],
+				"session": "`+session+`"
+			}`)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO3))
+
+			_, err := client.InvokeFunctionIterator(contract, "tokensOf", nil, nil)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "method not found")
+		})
+
+		t.Run("NEO2Node", func(t *testing.T) {
+			client := neo.NewClient("http://localhost:10332", neo.WithNetworkGeneration(neo.NEO2))
+
+			_, err := client.InvokeFunctionIterator(contract, "tokensOf", nil, nil)
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, err)
+		})
+	})
+}
@@ -0,0 +1,131 @@
+package neo
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+// ErrTransactionNotInBlock is returned by TransactionMerkleProof when the block does not
+// contain the transaction.
+var ErrTransactionNotInBlock = errors.New("transaction is not in the block")
+
+// TransactionMerkleProof returns the Merkle path of the transaction in the block, which
+// is the sibling hash at each level of the block's Merkle tree, from the transaction up
+// to the root. Together with the index of the transaction in the block, it proves that
+// the transaction is in the block without trusting the node, see VerifyMerkleProof.
+//
+// NEO builds the tree by hashing each pair of hashes, in their little-endian byte order,
+// with double SHA-256. A level with an odd number of hashes pairs the last one with
+// itself. The hashes are returned as "0x" prefixed big-endian hex, like transaction IDs.
+func TransactionMerkleProof(block *models.Block, txHash string) ([]string, error) {
+	if block == nil {
+		return nil, errors.New("block must not be nil")
+	}
+
+	normalizedTxHash, _ := normalizeTxHash(txHash)
+
+	index := -1
+	level := make([][]byte, 0, len(block.Transactions))
+
+	for i, transaction := range block.Transactions {
+		hash, err := decodeMerkleHash(transaction.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if normalized, _ := normalizeTxHash(transaction.ID); normalized == normalizedTxHash {
+			index = i
+		}
+
+		level = append(level, hash)
+	}
+
+	if index < 0 {
+		return nil, ErrTransactionNotInBlock
+	}
+
+	proof := []string{}
+
+	for len(level) > 1 {
+		if len(level)%2 == 1 {
+			level = append(level, level[len(level)-1])
+		}
+
+		proof = append(proof, encodeMerkleHash(level[index^1]))
+
+		parents := make([][]byte, 0, len(level)/2)
+		for i := 0; i < len(level); i += 2 {
+			parents = append(parents, merkleParent(level[i], level[i+1]))
+		}
+
+		level = parents
+		index /= 2
+	}
+
+	return proof, nil
+}
+
+// VerifyMerkleProof checks that the proof returned by TransactionMerkleProof leads from
+// the transaction, at the given index in its block, to the block's Merkle root.
+func VerifyMerkleProof(txHash string, index int, proof []string, merkleRoot string) (bool, error) {
+	if index < 0 {
+		return false, fmt.Errorf("index must not be negative, got: %d", index)
+	}
+
+	hash, err := decodeMerkleHash(txHash)
+	if err != nil {
+		return false, err
+	}
+
+	root, err := decodeMerkleHash(merkleRoot)
+	if err != nil {
+		return false, err
+	}
+
+	for _, siblingHash := range proof {
+		sibling, err := decodeMerkleHash(siblingHash)
+		if err != nil {
+			return false, err
+		}
+
+		if index%2 == 0 {
+			hash = merkleParent(hash, sibling)
+		} else {
+			hash = merkleParent(sibling, hash)
+		}
+
+		index /= 2
+	}
+
+	return index == 0 && bytes.Equal(hash, root), nil
+}
+
+// merkleParent returns the hash of the node whose children are left and right.
+func merkleParent(left, right []byte) []byte {
+	firstSHA := sha256.Sum256(append(append([]byte{}, left...), right...))
+	secondSHA := sha256.Sum256(firstSHA[:])
+
+	return secondSHA[:]
+}
+
+// decodeMerkleHash decodes a "0x" prefixed big-endian hash into the little-endian bytes
+// which are hashed in the Merkle tree.
+func decodeMerkleHash(hash string) ([]byte, error) {
+	normalized, ok := normalizeTxHash(hash)
+	if !ok {
+		return nil, fmt.Errorf("hash is not 32 bytes of hex, got: '%s'", hash)
+	}
+
+	decoded, _ := hex.DecodeString(strings.TrimPrefix(normalized, "0x"))
+	return reverseBytes(decoded), nil
+}
+
+func encodeMerkleHash(hash []byte) string {
+	return "0x" + hex.EncodeToString(reverseBytes(hash))
+}
@@ -0,0 +1,121 @@
+package neo_test
+
+import (
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerkle(t *testing.T) {
+	// the genesis block of the NEO2 MainNet
+	genesisMerkleRoot := "0x803ff4abe3ea6533bcc0be574efa02f83ae8fdc651c879056b0d9be336c01bf4"
+	genesisTxHashes := []string{
+		"0xfb5bd72b2d6792d75dc2f1084ffa9e9f70ca85543c717a6b13d9959b452a57d6",
+		"0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b",
+		"0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7",
+		"0x3631f66024ca6f5b033d7e0809eb993443374830025af904fb51b0334f127cda",
+	}
+
+	newBlock := func(merkleRoot string, txHashes []string) *models.Block {
+		block := &models.Block{Merkleroot: merkleRoot}
+		for _, txHash := range txHashes {
+			block.Transactions = append(block.Transactions, models.Transaction{ID: txHash})
+		}
+
+		return block
+	}
+
+	t.Run("TransactionMerkleProof()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			block := newBlock(genesisMerkleRoot, genesisTxHashes)
+
+			proof, err := neo.TransactionMerkleProof(block, genesisTxHashes[3])
+			assert.NoError(t, err)
+			assert.Equal(t, []string{
+				genesisTxHashes[2],
+				"0x8426508602ebf2d639093cca504dd7ec01d67840d3932ea82c14e01f5c2eb364",
+			}, proof)
+
+			for index, txHash := range genesisTxHashes {
+				proof, err := neo.TransactionMerkleProof(block, txHash)
+				assert.NoError(t, err)
+
+				ok, err := neo.VerifyMerkleProof(txHash, index, proof, block.Merkleroot)
+				assert.NoError(t, err)
+				assert.True(t, ok)
+			}
+		})
+
+		t.Run("OddNumberOfTransactions", func(t *testing.T) {
+			block := newBlock(
+				"0xf963d327f3bdfa03a4ecf83ef8bd7b90e7a0d6eef5071eb6383265520f9b20b7",
+				genesisTxHashes[:3],
+			)
+
+			proof, err := neo.TransactionMerkleProof(block, genesisTxHashes[2])
+			assert.NoError(t, err)
+			assert.Equal(t, genesisTxHashes[2], proof[0])
+
+			ok, err := neo.VerifyMerkleProof(genesisTxHashes[2], 2, proof, block.Merkleroot)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+		})
+
+		t.Run("SingleTransaction", func(t *testing.T) {
+			block := newBlock(genesisTxHashes[0], genesisTxHashes[:1])
+
+			proof, err := neo.TransactionMerkleProof(block, genesisTxHashes[0])
+			assert.NoError(t, err)
+			assert.Empty(t, proof)
+
+			ok, err := neo.VerifyMerkleProof(genesisTxHashes[0], 0, proof, block.Merkleroot)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			block := newBlock(genesisMerkleRoot, genesisTxHashes)
+
+			_, err := neo.TransactionMerkleProof(block, testTransactions[0].hash)
+			assert.Equal(t, neo.ErrTransactionNotInBlock, err)
+
+			_, err = neo.TransactionMerkleProof(nil, genesisTxHashes[0])
+			assert.Error(t, err)
+
+			_, err = neo.TransactionMerkleProof(newBlock(genesisMerkleRoot, []string{"0x1234"}), genesisTxHashes[0])
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("VerifyMerkleProof()", func(t *testing.T) {
+		block := newBlock(genesisMerkleRoot, genesisTxHashes)
+
+		proof, err := neo.TransactionMerkleProof(block, genesisTxHashes[1])
+		assert.NoError(t, err)
+
+		t.Run("WrongIndex", func(t *testing.T) {
+			ok, err := neo.VerifyMerkleProof(genesisTxHashes[1], 0, proof, genesisMerkleRoot)
+			assert.NoError(t, err)
+			assert.False(t, ok)
+		})
+
+		t.Run("WrongTransaction", func(t *testing.T) {
+			ok, err := neo.VerifyMerkleProof(testTransactions[0].hash, 1, proof, genesisMerkleRoot)
+			assert.NoError(t, err)
+			assert.False(t, ok)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			_, err := neo.VerifyMerkleProof(genesisTxHashes[1], -1, proof, genesisMerkleRoot)
+			assert.Error(t, err)
+
+			_, err = neo.VerifyMerkleProof(genesisTxHashes[1], 1, []string{"0xzz"}, genesisMerkleRoot)
+			assert.Error(t, err)
+
+			_, err = neo.VerifyMerkleProof(genesisTxHashes[1], 1, proof, "")
+			assert.Error(t, err)
+		})
+	})
+}
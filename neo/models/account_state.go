@@ -0,0 +1,18 @@
+package models
+
+type (
+	// AccountState holds the global asset (NEO, GAS, etc.) balances of an address.
+	AccountState struct {
+		Version    int64            `json:"version"`
+		ScriptHash string           `json:"script_hash"`
+		Frozen     bool             `json:"frozen"`
+		Votes      []string         `json:"votes"`
+		Balances   []AccountBalance `json:"balances"`
+	}
+
+	// AccountBalance holds the balance of a single asset within an AccountState.
+	AccountBalance struct {
+		Asset string `json:"asset"`
+		Value Fixed8 `json:"value"`
+	}
+)
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+type (
+	// ApplicationLog holds the executions of the scripts of a transaction.
+	ApplicationLog struct {
+		TransactionID string      `json:"txid"`
+		Executions    []Execution `json:"executions"`
+	}
+
+	// Execution holds the outcome of one execution of a transaction's script. VMState is
+	// "HALT" when the execution succeeded and contains "FAULT" when it failed. Contract is
+	// only set by NEO2 nodes. GasConsumed is a decimal string, such as "2.855", for nodes
+	// of both generations. The Stack is left undecoded, use ParseExecutionStack on the
+	// Client to decode it into stack items.
+	Execution struct {
+		Trigger       string            `json:"trigger"`
+		Contract      string            `json:"contract"`
+		VMState       string            `json:"vmstate"`
+		GasConsumed   string            `json:"gas_consumed"`
+		Stack         []json.RawMessage `json:"stack"`
+		Notifications []Notification    `json:"notifications"`
+	}
+
+	// Notification is an event raised by a contract during an execution, such as a NEP-5
+	// transfer. State is usually an array stack item, whose first item is the name of the
+	// event on NEO2 nodes. EventName is only set by NEO3 nodes.
+	Notification struct {
+		Contract  string    `json:"contract"`
+		EventName string    `json:"eventname"`
+		State     StackItem `json:"state"`
+	}
+
+	// executionJSON is the JSON shape of an Execution, NEO2 nodes report the GAS consumed
+	// as a decimal in "gas_consumed", while NEO3 nodes report it as an integer number of
+	// 1e-8 GAS in "gasconsumed".
+	executionJSON struct {
+		Trigger        string            `json:"trigger"`
+		Contract       string            `json:"contract"`
+		VMState        string            `json:"vmstate"`
+		GasConsumed    string            `json:"gas_consumed"`
+		GasConsumedNEO string            `json:"gasconsumed"`
+		Stack          []json.RawMessage `json:"stack"`
+		Notifications  []Notification    `json:"notifications"`
+	}
+)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *Execution) UnmarshalJSON(data []byte) error {
+	var execution executionJSON
+	if err := json.Unmarshal(data, &execution); err != nil {
+		return err
+	}
+
+	*e = Execution{
+		Trigger:       execution.Trigger,
+		Contract:      execution.Contract,
+		VMState:       execution.VMState,
+		GasConsumed:   execution.GasConsumed,
+		Stack:         execution.Stack,
+		Notifications: execution.Notifications,
+	}
+
+	if execution.GasConsumedNEO != "" {
+		gasConsumed, err := strconv.ParseInt(execution.GasConsumedNEO, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		e.GasConsumed = Fixed8(gasConsumed).String()
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package models
+
+type (
+	// AssetBalance holds the balance of a global asset of an address along with the
+	// metadata of the asset, for display. DisplayValue is Value formatted with Precision
+	// decimal places, such as "100" for NEO and "1.50000000" for GAS.
+	AssetBalance struct {
+		Asset        string `json:"asset"`
+		Symbol       string `json:"symbol"`
+		Name         string `json:"name"`
+		Precision    int    `json:"precision"`
+		Value        Fixed8 `json:"value"`
+		DisplayValue string `json:"display_value"`
+	}
+)
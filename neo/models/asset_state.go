@@ -0,0 +1,46 @@
+package models
+
+import "strings"
+
+type (
+	// AssetState holds the metadata of a NEO2 global asset, such as NEO or GAS. Amount is
+	// the total amount of the asset, or -0.00000001 when it is unlimited, and Available is
+	// the amount issued so far. Owner is the public key of the owner, while Admin and
+	// Issuer are addresses.
+	AssetState struct {
+		Version    int64       `json:"version"`
+		ID         string      `json:"id"`
+		Type       string      `json:"type"`
+		Name       []AssetName `json:"name"`
+		Amount     Fixed8      `json:"amount"`
+		Available  Fixed8      `json:"available"`
+		Precision  int         `json:"precision"`
+		Owner      string      `json:"owner"`
+		Admin      string      `json:"admin"`
+		Issuer     string      `json:"issuer"`
+		Expiration int64       `json:"expiration"`
+		Frozen     bool        `json:"frozen"`
+	}
+
+	// AssetName is the name of an asset in one language, such as "en".
+	AssetName struct {
+		Lang string `json:"lang"`
+		Name string `json:"name"`
+	}
+)
+
+// LocalizedName returns the name of the asset in the language, falling back to the first
+// name when there is none in that language.
+func (a AssetState) LocalizedName(lang string) string {
+	for _, name := range a.Name {
+		if strings.EqualFold(name.Lang, lang) {
+			return name.Name
+		}
+	}
+
+	if len(a.Name) > 0 {
+		return a.Name[0].Name
+	}
+
+	return ""
+}
@@ -0,0 +1,20 @@
+package models
+
+type (
+	// BlockHeader holds the data about a particular block on the blockchain, without the
+	// transactions.
+	BlockHeader struct {
+		Confirmations     int64  `json:"Confirmations"`
+		Hash              string `json:"Hash"`
+		Index             int64  `json:"Index"`
+		Merkleroot        string `json:"Merkleroot"`
+		NextBlockHash     string `json:"Nextblockhash"`
+		NextConsensus     string `json:"Nextconsensus"`
+		Nonce             string `json:"Nonce"`
+		PreviousBlockHash string `json:"Previousblockhash"`
+		Size              int64  `json:"Size"`
+		Time              int64  `json:"Time"`
+		Version           int64  `json:"Version"`
+		Script            Script `json:"Script"`
+	}
+)
@@ -0,0 +1,35 @@
+package models
+
+type (
+	// Claimable holds the spent NEO outputs of an address from which GAS can be claimed,
+	// as returned by the getclaimable method of NEO2 nodes.
+	Claimable struct {
+		Address   string            `json:"address"`
+		Claims    []ClaimableOutput `json:"claimable"`
+		Unclaimed Fixed8            `json:"unclaimed"`
+	}
+
+	// ClaimableOutput is a spent NEO output, and the GAS which can be claimed for it.
+	// Unclaimed is the sum of the GAS generated by the output while it was unspent, and
+	// the system fees paid over the same blocks.
+	ClaimableOutput struct {
+		TransactionID string `json:"txid"`
+		Index         int64  `json:"n"`
+		Value         Fixed8 `json:"value"`
+		StartHeight   int64  `json:"start_height"`
+		EndHeight     int64  `json:"end_height"`
+		Generated     Fixed8 `json:"generated"`
+		SysFee        Fixed8 `json:"sys_fee"`
+		Unclaimed     Fixed8 `json:"unclaimed"`
+	}
+
+	// Unclaimed holds the GAS of an address which is yet to be claimed, as returned by the
+	// getunclaimed method of NEO2 nodes. Available is the GAS of spent NEO outputs, which
+	// can be claimed now, and Unavailable the GAS of unspent NEO outputs, which can only be
+	// claimed once they are spent. Unclaimed is their sum.
+	Unclaimed struct {
+		Available   Fixed8 `json:"available"`
+		Unavailable Fixed8 `json:"unavailable"`
+		Unclaimed   Fixed8 `json:"unclaimed"`
+	}
+)
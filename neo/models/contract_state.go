@@ -0,0 +1,27 @@
+package models
+
+type (
+	// ContractState holds a smart contract deployed on a NEO2 network. Script is the hex
+	// encoded script of the contract, ParameterList and ReturnType hold the parameter
+	// types of its entry point, such as "ByteArray".
+	ContractState struct {
+		Version       int64              `json:"version"`
+		Hash          string             `json:"hash"`
+		Script        string             `json:"script"`
+		ParameterList []string           `json:"parameters"`
+		ReturnType    string             `json:"returntype"`
+		Name          string             `json:"name"`
+		CodeVersion   string             `json:"code_version"`
+		Author        string             `json:"author"`
+		Email         string             `json:"email"`
+		Description   string             `json:"description"`
+		Properties    ContractProperties `json:"properties"`
+	}
+
+	// ContractProperties holds the features a contract was deployed with.
+	ContractProperties struct {
+		Storage       bool `json:"storage"`
+		DynamicInvoke bool `json:"dynamic_invoke"`
+		Payable       bool `json:"payable"`
+	}
+)
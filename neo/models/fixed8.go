@@ -0,0 +1,119 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+type (
+	// Fixed8 is an exact monetary amount, stored as an integer number of 1e-8 units in
+	// the same way as the NEO node. It is encoded in JSON as a decimal string, and can be
+	// decoded from either a JSON string or number without losing precision.
+	Fixed8 int64
+)
+
+// Fixed8Decimals is the number of Fixed8 units in one whole unit of an asset.
+const Fixed8Decimals = 100000000
+
+var (
+	// fixed8Pattern matches a plain decimal, big.Rat also parses fractions and exponents.
+	fixed8Pattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+	fixed8DecimalsRat = new(big.Rat).SetInt64(Fixed8Decimals)
+	fixed8Max         = new(big.Int).SetInt64(1<<63 - 1)
+	fixed8Min         = new(big.Int).SetInt64(-1 << 63)
+)
+
+// NewFixed8 creates a Fixed8 from a number of whole units.
+func NewFixed8(value int64) Fixed8 {
+	return Fixed8(value * Fixed8Decimals)
+}
+
+// ParseFixed8 parses a decimal string, such as "0.00000001" or "100", into a Fixed8. An
+// error is returned if the value is not a plain decimal (such as "1/2" or "1e-8"), has
+// more than 8 decimal places or is out of range.
+func ParseFixed8(s string) (Fixed8, error) {
+	if !fixed8Pattern.MatchString(strings.TrimSpace(s)) {
+		return 0, fmt.Errorf("invalid Fixed8 value: '%s'", s)
+	}
+
+	return parseFixed8Number(s)
+}
+
+// parseFixed8Number parses a decimal number, which may have an exponent as JSON numbers
+// can, into a Fixed8.
+func parseFixed8Number(s string) (Fixed8, error) {
+	rat, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return 0, fmt.Errorf("invalid Fixed8 value: '%s'", s)
+	}
+
+	rat.Mul(rat, fixed8DecimalsRat)
+	if !rat.IsInt() {
+		return 0, fmt.Errorf("Fixed8 value has more than 8 decimal places: '%s'", s)
+	}
+
+	value := rat.Num()
+	if value.Cmp(fixed8Max) > 0 || value.Cmp(fixed8Min) < 0 {
+		return 0, fmt.Errorf("Fixed8 value is out of range: '%s'", s)
+	}
+
+	return Fixed8(value.Int64()), nil
+}
+
+// String returns the value as a decimal string, without trailing zeros.
+func (f Fixed8) String() string {
+	value := new(big.Int).SetInt64(int64(f))
+	sign := ""
+	if value.Sign() < 0 {
+		sign = "-"
+		value.Neg(value)
+	}
+
+	digits := fmt.Sprintf("%09s", value.String())
+	whole := digits[:len(digits)-8]
+	fraction := strings.TrimRight(digits[len(digits)-8:], "0")
+
+	if fraction == "" {
+		return sign + whole
+	}
+
+	return sign + whole + "." + fraction
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (f Fixed8) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, it accepts a JSON string
+// holding a plain decimal, or a JSON number. A JSON null leaves the value unchanged.
+func (f *Fixed8) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	parse := parseFixed8Number
+
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+
+		parse = ParseFixed8
+	}
+
+	value, err := parse(s)
+	if err != nil {
+		return err
+	}
+
+	*f = value
+	return nil
+}
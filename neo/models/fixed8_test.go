@@ -0,0 +1,72 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixed8(t *testing.T) {
+	t.Run(".UnmarshalJSON()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			testCases := []struct {
+				json     string
+				expected models.Fixed8
+				output   string
+			}{
+				{json: `"2"`, expected: 200000000, output: `"2"`},
+				{json: `2`, expected: 200000000, output: `"2"`},
+				{json: `"0.00000001"`, expected: 1, output: `"0.00000001"`},
+				{json: `0.00000001`, expected: 1, output: `"0.00000001"`},
+				{json: `1e-8`, expected: 1, output: `"0.00000001"`},
+				{json: `"1234.5678"`, expected: 123456780000, output: `"1234.5678"`},
+				{json: `0.1`, expected: 10000000, output: `"0.1"`},
+				{json: `"-0.5"`, expected: -50000000, output: `"-0.5"`},
+				{json: `"0"`, expected: 0, output: `"0"`},
+				{json: `"92233720368.54775807"`, expected: 9223372036854775807, output: `"92233720368.54775807"`},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.json, func(t *testing.T) {
+					var value models.Fixed8
+
+					err := json.Unmarshal([]byte(testCase.json), &value)
+					assert.NoError(t, err)
+					assert.Equal(t, testCase.expected, value)
+
+					output, err := json.Marshal(value)
+					assert.NoError(t, err)
+					assert.Equal(t, testCase.output, string(output))
+
+					var roundTrip models.Fixed8
+					assert.NoError(t, json.Unmarshal(output, &roundTrip))
+					assert.Equal(t, value, roundTrip)
+				})
+			}
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			for _, input := range []string{
+				`"abc"`, `"0.000000001"`, `"92233720368.54775808"`, `true`,
+				`"1/2"`, `"1e-8"`, `"1E8"`, `"0x10"`, `".5"`, `"1."`, `"+1"`, `""`,
+			} {
+				t.Run(input, func(t *testing.T) {
+					var value models.Fixed8
+
+					err := json.Unmarshal([]byte(input), &value)
+					assert.Error(t, err)
+				})
+			}
+		})
+
+		t.Run("Struct", func(t *testing.T) {
+			var vout models.Vout
+
+			err := json.Unmarshal([]byte(`{"n": 0, "value": 1.00000001}`), &vout)
+			assert.NoError(t, err)
+			assert.Equal(t, models.Fixed8(100000001), vout.Value)
+		})
+	})
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+type (
+	// InvokeResult holds the outcome of a test invocation of a smart contract. State is
+	// "HALT" when the invocation succeeded and contains "FAULT" when it failed, in which
+	// case NEO3 nodes give the reason in Exception. GasConsumed is the GAS the invocation
+	// would cost, as a decimal string such as "0.0202833", for nodes of both generations.
+	// Session is set by NEO3 nodes with sessions enabled, for traversing the iterators on
+	// the stack.
+	InvokeResult struct {
+		Script      string      `json:"script"`
+		State       string      `json:"state"`
+		GasConsumed string      `json:"gas_consumed"`
+		Exception   string      `json:"exception"`
+		Stack       []StackItem `json:"stack"`
+		Session     string      `json:"session"`
+	}
+
+	// invokeResultJSON is the JSON shape of an InvokeResult, NEO2 nodes report the GAS
+	// consumed as a decimal in "gas_consumed", while NEO3 nodes report it as an integer
+	// number of 1e-8 GAS in "gasconsumed".
+	invokeResultJSON struct {
+		Script         string      `json:"script"`
+		State          string      `json:"state"`
+		GasConsumed    string      `json:"gas_consumed"`
+		GasConsumedNEO string      `json:"gasconsumed"`
+		Exception      string      `json:"exception"`
+		Stack          []StackItem `json:"stack"`
+		Session        string      `json:"session"`
+	}
+)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *InvokeResult) UnmarshalJSON(data []byte) error {
+	var result invokeResultJSON
+	if err := json.Unmarshal(data, &result); err != nil {
+		return err
+	}
+
+	*r = InvokeResult{
+		Script:      result.Script,
+		State:       result.State,
+		GasConsumed: result.GasConsumed,
+		Exception:   result.Exception,
+		Stack:       result.Stack,
+		Session:     result.Session,
+	}
+
+	if result.GasConsumedNEO != "" {
+		gasConsumed, err := strconv.ParseInt(result.GasConsumedNEO, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		r.GasConsumed = Fixed8(gasConsumed).String()
+	}
+
+	return nil
+}
+
+// GasConsumedFixed8 returns GasConsumed as a Fixed8, for exact fee calculations.
+func (r InvokeResult) GasConsumedFixed8() (Fixed8, error) {
+	return ParseFixed8(r.GasConsumed)
+}
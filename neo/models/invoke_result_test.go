@@ -0,0 +1,78 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvokeResult(t *testing.T) {
+	t.Run("UnmarshalJSON", func(t *testing.T) {
+		t.Run("NEO2", func(t *testing.T) {
+			var result models.InvokeResult
+			err := json.Unmarshal([]byte(`{
+				"script": "00c1046e616d65",
+				"state": "HALT, BREAK",
+				"gas_consumed": "0.126",
+				"stack": [{"type": "ByteArray", "value": "6e656f"}]
+			}`), &result)
+			assert.NoError(t, err)
+			assert.Equal(t, "HALT, BREAK", result.State)
+			assert.Equal(t, "0.126", result.GasConsumed)
+			assert.Len(t, result.Stack, 1)
+		})
+
+		t.Run("NEO3", func(t *testing.T) {
+			var result models.InvokeResult
+			err := json.Unmarshal([]byte(`{
+				"script": "wh8MBG5hbWU=",
+				"state": "FAULT",
+				"gasconsumed": "2028330",
+				"exception": "method not found",
+				"stack": []
+			}`), &result)
+			assert.NoError(t, err)
+			assert.Equal(t, "FAULT", result.State)
+			assert.Equal(t, "0.0202833", result.GasConsumed)
+			assert.Equal(t, "method not found", result.Exception)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			var result models.InvokeResult
+			err := json.Unmarshal([]byte(`{"gasconsumed": "lots"}`), &result)
+			assert.Error(t, err)
+		})
+	})
+	t.Run(".GasConsumedFixed8()", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			gasConsumed string
+			expected    models.Fixed8
+			err         bool
+		}{
+			{description: "Typical", gasConsumed: "0.0202833", expected: 2028330},
+			{description: "Zero", gasConsumed: "0", expected: 0},
+			{description: "Large", gasConsumed: "92233720368.54775807", expected: 1<<63 - 1},
+			{description: "OutOfRange", gasConsumed: "92233720368.54775808", err: true},
+			{description: "TooPrecise", gasConsumed: "0.000000001", err: true},
+			{description: "Empty", gasConsumed: "", err: true},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.description, func(t *testing.T) {
+				result := models.InvokeResult{GasConsumed: testCase.gasConsumed}
+
+				gasConsumed, err := result.GasConsumedFixed8()
+				if testCase.err {
+					assert.Error(t, err)
+					return
+				}
+
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.expected, gasConsumed)
+			})
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package models
+
+type (
+	// NEP17Balances holds the NEP-17 token balances of an address, as returned by a NEO3
+	// node. NEP-17 is the NEO3 successor of the NEO2 NEP-5 token standard.
+	NEP17Balances struct {
+		Address  string         `json:"address"`
+		Balances []NEP17Balance `json:"balance"`
+	}
+
+	// NEP17Balance holds the balance of a single token within NEP17Balances. Amount is the
+	// raw integer amount, it is divided by 10^Decimals to get the token amount.
+	NEP17Balance struct {
+		AssetHash        string `json:"assethash"`
+		Name             string `json:"name"`
+		Symbol           string `json:"symbol"`
+		Decimals         string `json:"decimals"`
+		Amount           string `json:"amount"`
+		LastUpdatedBlock int64  `json:"lastupdatedblock"`
+	}
+
+	// NEP17Transfers holds the NEP-17 transfers sent and received by an address, as
+	// returned by a NEO3 node.
+	NEP17Transfers struct {
+		Address  string          `json:"address"`
+		Sent     []NEP17Transfer `json:"sent"`
+		Received []NEP17Transfer `json:"received"`
+	}
+
+	// NEP17Transfer is a single NEP-17 transfer within NEP17Transfers. Timestamp is in
+	// milliseconds, TransferAddress is the other party of the transfer and is empty when
+	// tokens are minted or burned.
+	NEP17Transfer struct {
+		Timestamp           int64  `json:"timestamp"`
+		AssetHash           string `json:"assethash"`
+		TransferAddress     string `json:"transferaddress"`
+		Amount              string `json:"amount"`
+		BlockIndex          int64  `json:"blockindex"`
+		TransferNotifyIndex int64  `json:"transfernotifyindex"`
+		TransactionHash     string `json:"txhash"`
+	}
+)
@@ -0,0 +1,40 @@
+package models
+
+type (
+	// NEP5Balances holds the NEP-5 token balances of an address, as returned by a NEO2
+	// node.
+	NEP5Balances struct {
+		Address  string        `json:"address"`
+		Balances []NEP5Balance `json:"balance"`
+	}
+
+	// NEP5Balance holds the balance of a single token within NEP5Balances. Amount is the
+	// raw integer amount, in the token's smallest unit, it is divided by 10^decimals of
+	// the token to get the token amount.
+	NEP5Balance struct {
+		AssetHash        string `json:"asset_hash"`
+		Amount           string `json:"amount"`
+		LastUpdatedBlock int64  `json:"last_updated_block"`
+	}
+
+	// NEP5Transfers holds the NEP-5 transfers sent and received by an address, as returned
+	// by a NEO2 node.
+	NEP5Transfers struct {
+		Address  string         `json:"address"`
+		Sent     []NEP5Transfer `json:"sent"`
+		Received []NEP5Transfer `json:"received"`
+	}
+
+	// NEP5Transfer is a single NEP-5 transfer within NEP5Transfers. Timestamp is in
+	// seconds, TransferAddress is the other party of the transfer and is empty when
+	// tokens are minted or burned.
+	NEP5Transfer struct {
+		Timestamp           int64  `json:"timestamp"`
+		AssetHash           string `json:"asset_hash"`
+		TransferAddress     string `json:"transfer_address"`
+		Amount              string `json:"amount"`
+		BlockIndex          int64  `json:"block_index"`
+		TransferNotifyIndex int64  `json:"transfer_notify_index"`
+		TransactionHash     string `json:"tx_hash"`
+	}
+)
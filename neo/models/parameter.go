@@ -0,0 +1,251 @@
+package models
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+type (
+	// Parameter is a smart contract parameter, as passed to invokefunction. Type is one of
+	// the ParameterType constants, and Value holds a Go value matching the type:
+	//
+	//   - Boolean: bool
+	//   - Integer: *big.Int, any Go integer or a decimal string
+	//   - Hash160, Hash256: hex string, with or without the "0x" prefix
+	//   - ByteArray, Signature, PublicKey: hex string (base64 for NEO3 byte arrays)
+	//   - String: string
+	//   - Array: []Parameter
+	//   - Any, Void: nil
+	//
+	// Parameters are encoded to JSON in the {type, value} shape the node accepts, with
+	// integers as decimal strings and hashes as lowercase "0x" prefixed hex, so encoding is
+	// deterministic. When decoded, Integer values are *big.Int and hashes are normalized in
+	// the same way.
+	Parameter struct {
+		Type  string      `json:"type"`
+		Value interface{} `json:"value"`
+	}
+
+	// parameterJSON is the JSON shape of a Parameter.
+	parameterJSON struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+)
+
+// The parameter types accepted by the node.
+const (
+	ParameterTypeAny       = "Any"
+	ParameterTypeArray     = "Array"
+	ParameterTypeBoolean   = "Boolean"
+	ParameterTypeByteArray = "ByteArray"
+	ParameterTypeHash160   = "Hash160"
+	ParameterTypeHash256   = "Hash256"
+	ParameterTypeInteger   = "Integer"
+	ParameterTypePublicKey = "PublicKey"
+	ParameterTypeSignature = "Signature"
+	ParameterTypeString    = "String"
+	ParameterTypeVoid      = "Void"
+)
+
+// ParseParametersJSON decodes a JSON array of parameters, such as the parameters of an
+// invocation template stored in config.
+func ParseParametersJSON(data []byte) ([]Parameter, error) {
+	var parameters []Parameter
+	if err := json.Unmarshal(data, &parameters); err != nil {
+		return nil, err
+	}
+
+	return parameters, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p Parameter) MarshalJSON() ([]byte, error) {
+	value, err := p.normalizedValue()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		Type  string      `json:"type"`
+		Value interface{} `json:"value"`
+	}{
+		Type:  p.Type,
+		Value: value,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *Parameter) UnmarshalJSON(data []byte) error {
+	var raw parameterJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	isNull := len(raw.Value) == 0 || string(raw.Value) == "null"
+
+	var value interface{}
+
+	switch raw.Type {
+	case ParameterTypeAny, ParameterTypeVoid:
+		if !isNull {
+			return fmt.Errorf("%s parameter must not have a value", raw.Type)
+		}
+	case ParameterTypeBoolean:
+		var boolean bool
+		if err := json.Unmarshal(raw.Value, &boolean); err != nil {
+			return fmt.Errorf("invalid Boolean parameter value: %s", raw.Value)
+		}
+		value = boolean
+	case ParameterTypeInteger:
+		integer, ok := new(big.Int).SetString(strings.Trim(string(raw.Value), `"`), 10)
+		if !ok {
+			return fmt.Errorf("invalid Integer parameter value: %s", raw.Value)
+		}
+		value = integer
+	case ParameterTypeArray:
+		var parameters []Parameter
+		if err := json.Unmarshal(raw.Value, &parameters); err != nil {
+			return err
+		}
+		if parameters == nil {
+			parameters = []Parameter{}
+		}
+		value = parameters
+	case ParameterTypeByteArray, ParameterTypeString:
+		var s string
+		if err := json.Unmarshal(raw.Value, &s); err != nil {
+			return fmt.Errorf("invalid %s parameter value: %s", raw.Type, raw.Value)
+		}
+		value = s
+	case ParameterTypeHash160, ParameterTypeHash256, ParameterTypePublicKey, ParameterTypeSignature:
+		var s string
+		if err := json.Unmarshal(raw.Value, &s); err != nil {
+			return fmt.Errorf("invalid %s parameter value: %s", raw.Type, raw.Value)
+		}
+
+		normalized, err := Parameter{Type: raw.Type, Value: s}.normalizedValue()
+		if err != nil {
+			return err
+		}
+		value = normalized
+	default:
+		return fmt.Errorf("unsupported parameter type: '%s'", raw.Type)
+	}
+
+	parameter := Parameter{Type: raw.Type, Value: value}
+
+	*p = parameter
+	return nil
+}
+
+// normalizedValue checks the value matches the type, and returns it in the form it is
+// encoded to JSON.
+func (p Parameter) normalizedValue() (interface{}, error) {
+	switch p.Type {
+	case ParameterTypeAny, ParameterTypeVoid:
+		if p.Value != nil {
+			return nil, fmt.Errorf("%s parameter must not have a value", p.Type)
+		}
+		return nil, nil
+	case ParameterTypeBoolean:
+		if _, ok := p.Value.(bool); !ok {
+			return nil, fmt.Errorf("Boolean parameter value must be a bool, got: %T", p.Value)
+		}
+		return p.Value, nil
+	case ParameterTypeInteger:
+		integer, err := integerValue(p.Value)
+		if err != nil {
+			return nil, err
+		}
+		return integer.String(), nil
+	case ParameterTypeArray:
+		parameters, ok := p.Value.([]Parameter)
+		if !ok {
+			return nil, fmt.Errorf("Array parameter value must be a []Parameter, got: %T", p.Value)
+		}
+		if parameters == nil {
+			parameters = []Parameter{}
+		}
+		return parameters, nil
+	case ParameterTypeHash160, ParameterTypeHash256:
+		length := 20
+		if p.Type == ParameterTypeHash256 {
+			length = 32
+		}
+		return normalizedHex(p.Type, p.Value, length, true)
+	case ParameterTypePublicKey, ParameterTypeSignature:
+		return normalizedHex(p.Type, p.Value, 0, false)
+	case ParameterTypeByteArray, ParameterTypeString:
+		s, ok := p.Value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s parameter value must be a string, got: %T", p.Type, p.Value)
+		}
+		return s, nil
+	}
+
+	return nil, fmt.Errorf("unsupported parameter type: '%s'", p.Type)
+}
+
+// integerValue converts the supported Go representations of an Integer parameter.
+func integerValue(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		if v != nil {
+			return v, nil
+		}
+	case big.Int:
+		return &v, nil
+	case int:
+		return big.NewInt(int64(v)), nil
+	case int8:
+		return big.NewInt(int64(v)), nil
+	case int16:
+		return big.NewInt(int64(v)), nil
+	case int32:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	case uint:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint8:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint16:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint32:
+		return new(big.Int).SetUint64(uint64(v)), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	case string:
+		if integer, ok := new(big.Int).SetString(v, 10); ok {
+			return integer, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid Integer parameter value: %v", value)
+}
+
+// normalizedHex checks the value is a hex string, of length bytes when length is not 0,
+// and returns it in lowercase, with the "0x" prefix when prefixed is set.
+func normalizedHex(parameterType string, value interface{}, length int, prefixed bool) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s parameter value must be a string, got: %T", parameterType, value)
+	}
+
+	s = strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+
+	bytes, err := hex.DecodeString(s)
+	if err != nil || (length != 0 && len(bytes) != length) {
+		return "", fmt.Errorf("invalid %s parameter value: '%v'", parameterType, value)
+	}
+
+	if prefixed {
+		return "0x" + s, nil
+	}
+
+	return s, nil
+}
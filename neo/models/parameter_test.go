@@ -0,0 +1,151 @@
+package models_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParameter(t *testing.T) {
+	t.Run(".MarshalJSON()", func(t *testing.T) {
+		t.Run("RoundTrip", func(t *testing.T) {
+			testCases := []struct {
+				parameter models.Parameter
+				json      string
+				decoded   models.Parameter
+			}{
+				{
+					parameter: models.Parameter{Type: "Any"},
+					json:      `{"type":"Any","value":null}`,
+				},
+				{
+					parameter: models.Parameter{Type: "Void"},
+					json:      `{"type":"Void","value":null}`,
+				},
+				{
+					parameter: models.Parameter{Type: "Boolean", Value: true},
+					json:      `{"type":"Boolean","value":true}`,
+				},
+				{
+					parameter: models.Parameter{Type: "Integer", Value: 16},
+					json:      `{"type":"Integer","value":"16"}`,
+					decoded:   models.Parameter{Type: "Integer", Value: big.NewInt(16)},
+				},
+				{
+					parameter: models.Parameter{Type: "Integer", Value: "-100000000000000000000"},
+					json:      `{"type":"Integer","value":"-100000000000000000000"}`,
+					decoded: models.Parameter{Type: "Integer", Value: func() *big.Int {
+						integer, _ := new(big.Int).SetString("-100000000000000000000", 10)
+						return integer
+					}()},
+				},
+				{
+					parameter: models.Parameter{Type: "Hash160", Value: "ECC6B20D3CCAC1EE9EF109AF5A7CDB85706B1DF9"},
+					json:      `{"type":"Hash160","value":"0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9"}`,
+					decoded:   models.Parameter{Type: "Hash160", Value: "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9"},
+				},
+				{
+					parameter: models.Parameter{Type: "Hash256", Value: "0x9ceb257832478ef778c1f26ad916ef8cbf116c71fe3cd6c5a8f672c1663539ae"},
+					json:      `{"type":"Hash256","value":"0x9ceb257832478ef778c1f26ad916ef8cbf116c71fe3cd6c5a8f672c1663539ae"}`,
+				},
+				{
+					parameter: models.Parameter{Type: "ByteArray", Value: "6e656f"},
+					json:      `{"type":"ByteArray","value":"6e656f"}`,
+				},
+				{
+					parameter: models.Parameter{Type: "PublicKey", Value: "02028a99826edc0c97d18e22b6932373d908d323aa7f92656a77ec26e8861699ef"},
+					json:      `{"type":"PublicKey","value":"02028a99826edc0c97d18e22b6932373d908d323aa7f92656a77ec26e8861699ef"}`,
+				},
+				{
+					parameter: models.Parameter{Type: "Signature", Value: "B420E06F"},
+					json:      `{"type":"Signature","value":"b420e06f"}`,
+					decoded:   models.Parameter{Type: "Signature", Value: "b420e06f"},
+				},
+				{
+					parameter: models.Parameter{Type: "String", Value: "alice.neo"},
+					json:      `{"type":"String","value":"alice.neo"}`,
+				},
+				{
+					parameter: models.Parameter{Type: "Array", Value: []models.Parameter{
+						{Type: "String", Value: "transfer"},
+						{Type: "Array", Value: []models.Parameter{
+							{Type: "Integer", Value: big.NewInt(1)},
+						}},
+					}},
+					json: `{"type":"Array","value":[{"type":"String","value":"transfer"},{"type":"Array","value":[{"type":"Integer","value":"1"}]}]}`,
+				},
+				{
+					parameter: models.Parameter{Type: "Array", Value: []models.Parameter{}},
+					json:      `{"type":"Array","value":[]}`,
+				},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.parameter.Type, func(t *testing.T) {
+					encoded, err := json.Marshal(testCase.parameter)
+					assert.NoError(t, err)
+					assert.Equal(t, testCase.json, string(encoded))
+
+					var decoded models.Parameter
+					assert.NoError(t, json.Unmarshal(encoded, &decoded))
+
+					expected := testCase.decoded
+					if expected.Type == "" {
+						expected = testCase.parameter
+					}
+					assert.Equal(t, expected, decoded)
+
+					reencoded, err := json.Marshal(decoded)
+					assert.NoError(t, err)
+					assert.Equal(t, testCase.json, string(reencoded))
+				})
+			}
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			for _, parameter := range []models.Parameter{
+				{Type: "Boolean", Value: "true"},
+				{Type: "Integer", Value: 1.5},
+				{Type: "Hash160", Value: "0xecc6b20d"},
+				{Type: "Hash256", Value: "not hex"},
+				{Type: "Array", Value: "[]"},
+				{Type: "Any", Value: 1},
+				{Type: "Unknown", Value: "x"},
+			} {
+				_, err := json.Marshal(parameter)
+				assert.Error(t, err)
+			}
+		})
+	})
+
+	t.Run("ParseParametersJSON()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			parameters, err := models.ParseParametersJSON([]byte(`[
+				{"type": "Hash160", "value": "ecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9"},
+				{"type": "Integer", "value": 100},
+				{"type": "Array", "value": [{"type": "Boolean", "value": false}]}
+			]`))
+			assert.NoError(t, err)
+			assert.Equal(t, []models.Parameter{
+				{Type: "Hash160", Value: "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9"},
+				{Type: "Integer", Value: big.NewInt(100)},
+				{Type: "Array", Value: []models.Parameter{{Type: "Boolean", Value: false}}},
+			}, parameters)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			for _, data := range []string{
+				`{"type": "String", "value": "x"}`,
+				`[{"type": "Integer", "value": "1.5"}]`,
+				`[{"type": "Hash160", "value": "0x00"}]`,
+				`[{"type": "Map", "value": []}]`,
+			} {
+				_, err := models.ParseParametersJSON([]byte(data))
+				assert.Error(t, err)
+			}
+		})
+	})
+}
@@ -0,0 +1,16 @@
+package models
+
+type (
+	// Peers holds the peers of a NEO node, by the state of its connection to them.
+	Peers struct {
+		Connected   []Peer `json:"connected"`
+		Unconnected []Peer `json:"unconnected"`
+		Bad         []Peer `json:"bad"`
+	}
+
+	// Peer is the address and port of a peer of a NEO node.
+	Peer struct {
+		Address string `json:"address"`
+		Port    int    `json:"port"`
+	}
+)
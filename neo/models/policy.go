@@ -0,0 +1,12 @@
+package models
+
+type (
+	// Policy holds the fee settings of a NEO3 network, which are needed to work out the
+	// network and system fees of a transaction. FeePerByte and StoragePrice are in
+	// datoshi (1e-8 GAS), ExecFeeFactor multiplies the base price of each VM opcode.
+	Policy struct {
+		FeePerByte    int64 `json:"fee_per_byte"`
+		ExecFeeFactor int64 `json:"exec_fee_factor"`
+		StoragePrice  int64 `json:"storage_price"`
+	}
+)
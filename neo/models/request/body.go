@@ -1,6 +1,9 @@
 package request
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"io"
+)
 
 type (
 	// Body is a struct used as the body of a POST HTTP JSON-RPC request.
@@ -39,3 +42,32 @@ func NewBodyWithParameters(method string, parameters []interface{}) ([]byte, err
 
 	return json.Marshal(body)
 }
+
+// EncodeBody writes the JSON encoding of a Body, using the provided method and parameters,
+// to w. It allows the caller to reuse the buffer the body is written to.
+func EncodeBody(w io.Writer, method string, parameters []interface{}) error {
+	body := Body{
+		ID:         1,
+		Method:     method,
+		Parameters: parameters,
+		Version:    apiVersion,
+	}
+
+	return json.NewEncoder(w).Encode(body)
+}
+
+// EncodeBatchBody writes the JSON encoding of a JSON-RPC batch, one Body for each of the
+// methods and parameters, to w. The ID of each Body is its position in the batch plus one.
+func EncodeBatchBody(w io.Writer, methods []string, parameters [][]interface{}) error {
+	bodies := make([]Body, len(methods))
+	for i, method := range methods {
+		bodies[i] = Body{
+			ID:         int64(i + 1),
+			Method:     method,
+			Parameters: parameters[i],
+			Version:    apiVersion,
+		}
+	}
+
+	return json.NewEncoder(w).Encode(bodies)
+}
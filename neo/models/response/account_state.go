@@ -0,0 +1,13 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// AccountState represents the JSON schema of a response from a NEO node, where the
+	// expected result is the state of an account (address).
+	AccountState struct {
+		ID      int                 `json:"id"`
+		JSONRPC string              `json:"jsonrpc"`
+		Result  models.AccountState `json:"result"`
+	}
+)
@@ -0,0 +1,13 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// ApplicationLog represents the JSON schema of a response from a NEO node, where the
+	// expected result is the application log of a transaction.
+	ApplicationLog struct {
+		ID      int                   `json:"id"`
+		JSONRPC string                `json:"jsonrpc"`
+		Result  models.ApplicationLog `json:"result"`
+	}
+)
@@ -0,0 +1,13 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// AssetState represents the JSON schema of a response from a NEO2 node, where the
+	// expected result is the metadata of a global asset.
+	AssetState struct {
+		ID      int               `json:"id"`
+		JSONRPC string            `json:"jsonrpc"`
+		Result  models.AssetState `json:"result"`
+	}
+)
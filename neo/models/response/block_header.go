@@ -0,0 +1,13 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// BlockHeader represents the JSON schema of a response from a NEO node, where the
+	// expected result is the header of a particular block.
+	BlockHeader struct {
+		ID      int                `json:"id"`
+		JSONRPC string             `json:"jsonrpc"`
+		Result  models.BlockHeader `json:"result"`
+	}
+)
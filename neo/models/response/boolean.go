@@ -0,0 +1,11 @@
+package response
+
+type (
+	// Boolean represents the JSON schema of a response from a NEO node, where the expected
+	// result is a boolean.
+	Boolean struct {
+		ID      int    `json:"id"`
+		JSONRPC string `json:"jsonrpc"`
+		Result  bool   `json:"result"`
+	}
+)
@@ -0,0 +1,21 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// Claimable represents the JSON schema of a response from a NEO node, where the
+	// expected result is the claimable GAS of an address.
+	Claimable struct {
+		ID      int              `json:"id"`
+		JSONRPC string           `json:"jsonrpc"`
+		Result  models.Claimable `json:"result"`
+	}
+
+	// Unclaimed represents the JSON schema of a response from a NEO2 node, where the
+	// expected result is the unclaimed GAS of an address.
+	Unclaimed struct {
+		ID      int              `json:"id"`
+		JSONRPC string           `json:"jsonrpc"`
+		Result  models.Unclaimed `json:"result"`
+	}
+)
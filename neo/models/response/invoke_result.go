@@ -0,0 +1,21 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// InvokeResult represents the JSON schema of a response from a NEO node, where the
+	// expected result is the outcome of a test invocation.
+	InvokeResult struct {
+		ID      int                 `json:"id"`
+		JSONRPC string              `json:"jsonrpc"`
+		Result  models.InvokeResult `json:"result"`
+	}
+
+	// StackItems represents the JSON schema of a response from a NEO node, where the
+	// expected result is an array of stack items, such as the items of an iterator.
+	StackItems struct {
+		ID      int                `json:"id"`
+		JSONRPC string             `json:"jsonrpc"`
+		Result  []models.StackItem `json:"result"`
+	}
+)
@@ -0,0 +1,21 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// NEP17Balances represents the JSON schema of a response from a NEO3 node, where the
+	// expected result is the NEP-17 balances of an address.
+	NEP17Balances struct {
+		ID      int                  `json:"id"`
+		JSONRPC string               `json:"jsonrpc"`
+		Result  models.NEP17Balances `json:"result"`
+	}
+
+	// NEP17Transfers represents the JSON schema of a response from a NEO3 node, where the
+	// expected result is the NEP-17 transfers of an address.
+	NEP17Transfers struct {
+		ID      int                   `json:"id"`
+		JSONRPC string                `json:"jsonrpc"`
+		Result  models.NEP17Transfers `json:"result"`
+	}
+)
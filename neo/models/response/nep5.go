@@ -0,0 +1,21 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// NEP5Balances represents the JSON schema of a response from a NEO2 node, where the
+	// expected result is the NEP-5 token balances of an address.
+	NEP5Balances struct {
+		ID      int                 `json:"id"`
+		JSONRPC string              `json:"jsonrpc"`
+		Result  models.NEP5Balances `json:"result"`
+	}
+
+	// NEP5Transfers represents the JSON schema of a response from a NEO2 node, where the
+	// expected result is the NEP-5 transfers of an address.
+	NEP5Transfers struct {
+		ID      int                  `json:"id"`
+		JSONRPC string               `json:"jsonrpc"`
+		Result  models.NEP5Transfers `json:"result"`
+	}
+)
@@ -0,0 +1,13 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// Peers represents the JSON schema of a response from a NEO node, where the expected
+	// result is the peers of the node.
+	Peers struct {
+		ID      int          `json:"id"`
+		JSONRPC string       `json:"jsonrpc"`
+		Result  models.Peers `json:"result"`
+	}
+)
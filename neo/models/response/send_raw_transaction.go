@@ -0,0 +1,14 @@
+package response
+
+import "encoding/json"
+
+type (
+	// SendRawTransaction represents the JSON schema of a response from a NEO node to
+	// sendrawtransaction. NEO2 nodes return a boolean result, while NEO3 nodes return an
+	// object holding the hash of the transaction.
+	SendRawTransaction struct {
+		ID      int             `json:"id"`
+		JSONRPC string          `json:"jsonrpc"`
+		Result  json.RawMessage `json:"result"`
+	}
+)
@@ -0,0 +1,13 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// StateRoot represents the JSON schema of a response from a NEO3 node, where the
+	// expected result is the state root of a block.
+	StateRoot struct {
+		ID      int              `json:"id"`
+		JSONRPC string           `json:"jsonrpc"`
+		Result  models.StateRoot `json:"result"`
+	}
+)
@@ -0,0 +1,13 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// FoundStorage represents the JSON schema of a response from a NEO node, where the
+	// expected result is a page of storage entries.
+	FoundStorage struct {
+		ID      int                 `json:"id"`
+		JSONRPC string              `json:"jsonrpc"`
+		Result  models.FoundStorage `json:"result"`
+	}
+)
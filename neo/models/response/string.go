@@ -8,4 +8,12 @@ type (
 		JSONRPC string `json:"jsonrpc"`
 		Result  string `json:"result"`
 	}
+
+	// NullableString represents the JSON schema of a response from a NEO node, where the
+	// expected result is a string or null.
+	NullableString struct {
+		ID      int     `json:"id"`
+		JSONRPC string  `json:"jsonrpc"`
+		Result  *string `json:"result"`
+	}
 )
@@ -0,0 +1,13 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// Unspents represents the JSON schema of a response from a NEO node, where the
+	// expected result is the unspent outputs of an address.
+	Unspents struct {
+		ID      int             `json:"id"`
+		JSONRPC string          `json:"jsonrpc"`
+		Result  models.Unspents `json:"result"`
+	}
+)
@@ -0,0 +1,13 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// Validators represents the JSON schema of a response from a NEO node, where the
+	// expected result is an array of validators.
+	Validators struct {
+		ID      int                `json:"id"`
+		JSONRPC string             `json:"jsonrpc"`
+		Result  []models.Validator `json:"result"`
+	}
+)
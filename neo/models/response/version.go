@@ -0,0 +1,13 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// Version represents the JSON schema of a response from a NEO node, where the expected
+	// result is the version information of the node.
+	Version struct {
+		ID      int            `json:"id"`
+		JSONRPC string         `json:"jsonrpc"`
+		Result  models.Version `json:"result"`
+	}
+)
@@ -0,0 +1,21 @@
+package response
+
+import "github.com/lomocoin/neo-go-sdk/neo/models"
+
+type (
+	// WalletAddress represents the JSON schema of a response from a NEO node, where the
+	// expected result is an address of the open wallet.
+	WalletAddress struct {
+		ID      int                  `json:"id"`
+		JSONRPC string               `json:"jsonrpc"`
+		Result  models.WalletAddress `json:"result"`
+	}
+
+	// WalletAddresses represents the JSON schema of a response from a NEO node, where the
+	// expected result is the addresses of the open wallet.
+	WalletAddresses struct {
+		ID      int                    `json:"id"`
+		JSONRPC string                 `json:"jsonrpc"`
+		Result  []models.WalletAddress `json:"result"`
+	}
+)
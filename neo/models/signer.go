@@ -0,0 +1,37 @@
+package models
+
+type (
+	// Signer is an account which signs a NEO3 transaction, and the scope in which its
+	// witness is valid. Account is the script hash of the account, 0x prefixed and
+	// big-endian. AllowedContracts and AllowedGroups are only used with the
+	// WitnessScopeCustomContracts and WitnessScopeCustomGroups scopes.
+	Signer struct {
+		Account          string       `json:"account"`
+		Scopes           WitnessScope `json:"scopes"`
+		AllowedContracts []string     `json:"allowedcontracts,omitempty"`
+		AllowedGroups    []string     `json:"allowedgroups,omitempty"`
+	}
+
+	// WitnessScope limits which contracts a witness is valid for. Scopes can be combined
+	// by joining them with a comma, such as "CalledByEntry, CustomContracts".
+	WitnessScope string
+)
+
+const (
+	// WitnessScopeNone means the witness is only used for the transaction fee.
+	WitnessScopeNone WitnessScope = "None"
+
+	// WitnessScopeCalledByEntry means the witness is only valid in the contract called by
+	// the transaction's script, and not in contracts that it calls.
+	WitnessScopeCalledByEntry WitnessScope = "CalledByEntry"
+
+	// WitnessScopeCustomContracts means the witness is valid in the AllowedContracts.
+	WitnessScopeCustomContracts WitnessScope = "CustomContracts"
+
+	// WitnessScopeCustomGroups means the witness is valid in contracts of the
+	// AllowedGroups.
+	WitnessScopeCustomGroups WitnessScope = "CustomGroups"
+
+	// WitnessScopeGlobal means the witness is valid in every contract.
+	WitnessScopeGlobal WitnessScope = "Global"
+)
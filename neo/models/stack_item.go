@@ -0,0 +1,148 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+type (
+	// StackItem is an item on the stack left by a smart contract invocation. Value holds
+	// the raw JSON value, which depends on Type, and is decoded with the As* methods.
+	//
+	// NEO2 nodes encode byte arrays as hex ("ByteArray"), while NEO3 nodes encode them as
+	// base64 ("ByteString" and "Buffer"). The NEO3 type names are always decoded as
+	// base64, Encoding is used to choose the encoding of "ByteArray" items.
+	//
+	// Interface and ID are only set for "InteropInterface" items returned by NEO3 nodes
+	// with sessions enabled, an iterator has the Interface "IIterator" and is traversed by
+	// its ID, see InvokeFunctionIterator on the Client.
+	StackItem struct {
+		Type      string          `json:"type"`
+		Value     json.RawMessage `json:"value"`
+		Interface string          `json:"interface,omitempty"`
+		ID        string          `json:"id,omitempty"`
+		Encoding  ByteEncoding    `json:"-"`
+	}
+
+	// ByteEncoding is the encoding of byte array stack items.
+	ByteEncoding int
+)
+
+const (
+	// ByteEncodingHex is the encoding used by NEO2 nodes.
+	ByteEncodingHex ByteEncoding = iota
+
+	// ByteEncodingBase64 is the encoding used by NEO3 nodes.
+	ByteEncodingBase64
+)
+
+// AsByteArray decodes the value of a byte array stack item.
+func (s StackItem) AsByteArray() ([]byte, error) {
+	switch s.Type {
+	case "ByteArray", "ByteString", "Buffer":
+	default:
+		return nil, fmt.Errorf("stack item of type '%s' is not a byte array", s.Type)
+	}
+
+	var value string
+	if err := json.Unmarshal(s.Value, &value); err != nil {
+		return nil, err
+	}
+
+	if s.Type != "ByteArray" || s.Encoding == ByteEncodingBase64 {
+		return base64.StdEncoding.DecodeString(value)
+	}
+
+	return hex.DecodeString(value)
+}
+
+// AsString decodes the value of a byte array stack item as a string.
+func (s StackItem) AsString() (string, error) {
+	bytes, err := s.AsByteArray()
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
+
+// AsInteger decodes the value of an integer stack item. Byte array stack items are
+// decoded as a little-endian two's complement integer, which is how integers are stored
+// in contract storage.
+func (s StackItem) AsInteger() (*big.Int, error) {
+	if s.Type != "Integer" {
+		bytes, err := s.AsByteArray()
+		if err != nil {
+			return nil, fmt.Errorf("stack item of type '%s' is not an integer", s.Type)
+		}
+
+		return IntegerFromLittleEndian(bytes), nil
+	}
+
+	var value string
+	if err := json.Unmarshal(s.Value, &value); err != nil {
+		return nil, err
+	}
+
+	integer, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer stack item value: '%s'", value)
+	}
+
+	return integer, nil
+}
+
+// AsBool decodes the value of a boolean stack item.
+func (s StackItem) AsBool() (bool, error) {
+	if s.Type != "Boolean" {
+		return false, fmt.Errorf("stack item of type '%s' is not a boolean", s.Type)
+	}
+
+	var value bool
+	if err := json.Unmarshal(s.Value, &value); err != nil {
+		return false, err
+	}
+
+	return value, nil
+}
+
+// AsArray decodes the items of an array (or struct) stack item, the items are decoded
+// with the same Encoding.
+func (s StackItem) AsArray() ([]StackItem, error) {
+	switch s.Type {
+	case "Array", "Struct":
+	default:
+		return nil, fmt.Errorf("stack item of type '%s' is not an array", s.Type)
+	}
+
+	var items []StackItem
+	if err := json.Unmarshal(s.Value, &items); err != nil {
+		return nil, err
+	}
+
+	for i := range items {
+		items[i].Encoding = s.Encoding
+	}
+
+	return items, nil
+}
+
+// IntegerFromLittleEndian decodes a little-endian two's complement integer, the encoding
+// of integers in the NEO virtual machine.
+func IntegerFromLittleEndian(bytes []byte) *big.Int {
+	bigEndian := make([]byte, len(bytes))
+	for i, b := range bytes {
+		bigEndian[len(bytes)-1-i] = b
+	}
+
+	integer := new(big.Int).SetBytes(bigEndian)
+
+	if len(bytes) > 0 && bytes[len(bytes)-1]&0x80 != 0 {
+		integer.Sub(integer, new(big.Int).Lsh(big.NewInt(1), uint(len(bytes)*8)))
+	}
+
+	return integer
+}
@@ -0,0 +1,122 @@
+package models_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStackItem(t *testing.T) {
+	decode := func(t *testing.T, itemJSON string, encoding models.ByteEncoding) models.StackItem {
+		var item models.StackItem
+		assert.NoError(t, json.Unmarshal([]byte(itemJSON), &item))
+		item.Encoding = encoding
+
+		return item
+	}
+
+	t.Run(".AsString()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			testCases := []struct {
+				json     string
+				encoding models.ByteEncoding
+			}{
+				{json: `{"type": "ByteArray", "value": "6e656f"}`, encoding: models.ByteEncodingHex},
+				{json: `{"type": "ByteArray", "value": "bmVv"}`, encoding: models.ByteEncodingBase64},
+				{json: `{"type": "ByteString", "value": "bmVv"}`, encoding: models.ByteEncodingHex},
+				{json: `{"type": "Buffer", "value": "bmVv"}`, encoding: models.ByteEncodingBase64},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.json, func(t *testing.T) {
+					value, err := decode(t, testCase.json, testCase.encoding).AsString()
+					assert.NoError(t, err)
+					assert.Equal(t, "neo", value)
+				})
+			}
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			_, err := decode(t, `{"type": "ByteArray", "value": "bmVv"}`, models.ByteEncodingHex).AsString()
+			assert.Error(t, err)
+
+			_, err = decode(t, `{"type": "Boolean", "value": true}`, models.ByteEncodingHex).AsString()
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run(".AsInteger()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			testCases := []struct {
+				json     string
+				encoding models.ByteEncoding
+				expected int64
+			}{
+				{json: `{"type": "Integer", "value": "100"}`, expected: 100},
+				{json: `{"type": "Integer", "value": "-5"}`, expected: -5},
+				{json: `{"type": "ByteArray", "value": "00e1f505"}`, expected: 100000000},
+				{json: `{"type": "ByteArray", "value": "ff"}`, expected: -1},
+				{json: `{"type": "ByteArray", "value": ""}`, expected: 0},
+				{json: `{"type": "ByteString", "value": "AOH1BQ=="}`, expected: 100000000},
+				{json: `{"type": "ByteArray", "value": "AOH1BQ=="}`, encoding: models.ByteEncodingBase64, expected: 100000000},
+			}
+
+			for _, testCase := range testCases {
+				t.Run(testCase.json, func(t *testing.T) {
+					value, err := decode(t, testCase.json, testCase.encoding).AsInteger()
+					assert.NoError(t, err)
+					assert.Equal(t, big.NewInt(testCase.expected), value)
+				})
+			}
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			_, err := decode(t, `{"type": "Integer", "value": "abc"}`, models.ByteEncodingHex).AsInteger()
+			assert.Error(t, err)
+
+			_, err = decode(t, `{"type": "Array", "value": []}`, models.ByteEncodingHex).AsInteger()
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run(".AsBool()", func(t *testing.T) {
+		value, err := decode(t, `{"type": "Boolean", "value": true}`, models.ByteEncodingHex).AsBool()
+		assert.NoError(t, err)
+		assert.True(t, value)
+
+		_, err = decode(t, `{"type": "Integer", "value": "1"}`, models.ByteEncodingHex).AsBool()
+		assert.Error(t, err)
+	})
+
+	t.Run(".AsArray()", func(t *testing.T) {
+		item := decode(t, `{
+			"type": "Array",
+			"value": [
+				{"type": "ByteArray", "value": "bmVv"},
+				{"type": "Integer", "value": "8"}
+			]
+		}`, models.ByteEncodingBase64)
+
+		items, err := item.AsArray()
+		assert.NoError(t, err)
+		assert.Len(t, items, 2)
+
+		symbol, err := items[0].AsString()
+		assert.NoError(t, err)
+		assert.Equal(t, "neo", symbol)
+
+		decimals, err := items[1].AsInteger()
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewInt(8), decimals)
+	})
+
+	t.Run("IntegerFromLittleEndian()", func(t *testing.T) {
+		assert.Equal(t, big.NewInt(0), models.IntegerFromLittleEndian(nil))
+		assert.Equal(t, big.NewInt(50000000), models.IntegerFromLittleEndian([]byte{0x80, 0xf0, 0xfa, 0x02}))
+		assert.Equal(t, big.NewInt(-1), models.IntegerFromLittleEndian([]byte{0xff}))
+		assert.Equal(t, big.NewInt(128), models.IntegerFromLittleEndian([]byte{0x80, 0x00}))
+	})
+}
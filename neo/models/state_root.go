@@ -0,0 +1,11 @@
+package models
+
+type (
+	// StateRoot holds the root hash of the state (contract storage) of the chain after
+	// the block at Index, as returned by the StateService plugin of a NEO3 node.
+	StateRoot struct {
+		Version  int64  `json:"version"`
+		Index    int64  `json:"index"`
+		RootHash string `json:"roothash"`
+	}
+)
@@ -0,0 +1,17 @@
+package models
+
+type (
+	// StorageEntry holds a key and value from the storage of a smart contract.
+	StorageEntry struct {
+		Key   []byte `json:"key"`
+		Value []byte `json:"value"`
+	}
+
+	// FoundStorage holds a page of the storage entries found under a key prefix. When
+	// Truncated is set there are more entries, starting from Next.
+	FoundStorage struct {
+		Truncated bool           `json:"truncated"`
+		Next      int            `json:"next"`
+		Results   []StorageEntry `json:"results"`
+	}
+)
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+type (
+	// TransactionAttribute holds an attribute of a NEO2 transaction. Data is hex encoded,
+	// and its meaning depends on the usage.
+	TransactionAttribute struct {
+		Usage string `json:"Usage"`
+		Data  string `json:"Data"`
+	}
+)
+
+// TransactionAttributeUsageScript is the usage of attributes which identify an account
+// that must witness the transaction, such as the caller of an invocation transaction.
+const TransactionAttributeUsageScript = "Script"
+
+// ScriptHash returns the script hash of a Script attribute, in 0x prefixed, big-endian,
+// hex. It can be converted to an address with neo.ScriptHashToAddress.
+func (a TransactionAttribute) ScriptHash() (string, error) {
+	if a.Usage != TransactionAttributeUsageScript {
+		return "", fmt.Errorf("transaction attribute of usage '%s' is not a script hash", a.Usage)
+	}
+
+	data, err := hex.DecodeString(a.Data)
+	if err != nil {
+		return "", err
+	}
+
+	if len(data) != 20 {
+		return "", fmt.Errorf("Expected length of script hash to be 20, got: %d", len(data))
+	}
+
+	// the script hash is serialized little-endian
+	for i, j := 0, len(data)-1; i < j; i, j = i+1, j-1 {
+		data[i], data[j] = data[j], data[i]
+	}
+
+	return "0x" + hex.EncodeToString(data), nil
+}
@@ -0,0 +1,38 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionAttribute(t *testing.T) {
+	t.Run(".ScriptHash()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			var attribute models.TransactionAttribute
+			err := json.Unmarshal([]byte(`{
+				"usage": "Script",
+				"data": "3775292229eccdf904f16fff8e83e7cffdc0f0ce"
+			}`), &attribute)
+			assert.NoError(t, err)
+
+			scriptHash, err := attribute.ScriptHash()
+			assert.NoError(t, err)
+			assert.Equal(t, "0xcef0c0fdcfe7838eff6ff104f9cdec2922297537", scriptHash)
+			assert.Equal(t, "3775292229eccdf904f16fff8e83e7cffdc0f0ce", attribute.Data)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			for _, attribute := range []models.TransactionAttribute{
+				{Usage: "Remark", Data: "68656c6c6f"},
+				{Usage: "Script", Data: "zz"},
+				{Usage: "Script", Data: "3775292229eccdf904f16fff8e83e7cffdc0f0"},
+			} {
+				_, err := attribute.ScriptHash()
+				assert.Error(t, err, attribute.Data)
+			}
+		})
+	})
+}
@@ -0,0 +1,40 @@
+package models
+
+type (
+	// TransactionType is the type of a NEO2 transaction, as named by the node.
+	TransactionType string
+)
+
+// The NEO2 transaction types.
+const (
+	TransactionTypeMiner      TransactionType = "MinerTransaction"
+	TransactionTypeIssue      TransactionType = "IssueTransaction"
+	TransactionTypeClaim      TransactionType = "ClaimTransaction"
+	TransactionTypeEnrollment TransactionType = "EnrollmentTransaction"
+	TransactionTypeRegister   TransactionType = "RegisterTransaction"
+	TransactionTypeContract   TransactionType = "ContractTransaction"
+	TransactionTypeState      TransactionType = "StateTransaction"
+	TransactionTypePublish    TransactionType = "PublishTransaction"
+	TransactionTypeInvocation TransactionType = "InvocationTransaction"
+)
+
+// TransactionType returns the type of the transaction.
+func (t Transaction) TransactionType() TransactionType {
+	return TransactionType(t.Type)
+}
+
+// IsInvocation reports whether the transaction invokes a smart contract.
+func (t Transaction) IsInvocation() bool {
+	return t.TransactionType() == TransactionTypeInvocation
+}
+
+// IsClaim reports whether the transaction claims GAS.
+func (t Transaction) IsClaim() bool {
+	return t.TransactionType() == TransactionTypeClaim
+}
+
+// IsContract reports whether the transaction is a contract transaction, which transfers
+// global assets such as NEO and GAS.
+func (t Transaction) IsContract() bool {
+	return t.TransactionType() == TransactionTypeContract
+}
@@ -0,0 +1,45 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionType(t *testing.T) {
+	t.Run(".TransactionType()", func(t *testing.T) {
+		for raw, expected := range map[string]models.TransactionType{
+			"MinerTransaction":      models.TransactionTypeMiner,
+			"IssueTransaction":      models.TransactionTypeIssue,
+			"ClaimTransaction":      models.TransactionTypeClaim,
+			"EnrollmentTransaction": models.TransactionTypeEnrollment,
+			"RegisterTransaction":   models.TransactionTypeRegister,
+			"ContractTransaction":   models.TransactionTypeContract,
+			"StateTransaction":      models.TransactionTypeState,
+			"PublishTransaction":    models.TransactionTypePublish,
+			"InvocationTransaction": models.TransactionTypeInvocation,
+		} {
+			var transaction models.Transaction
+			err := json.Unmarshal([]byte(`{"type": "`+raw+`"}`), &transaction)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, transaction.TransactionType())
+		}
+	})
+
+	t.Run(".IsInvocation()", func(t *testing.T) {
+		assert.True(t, models.Transaction{Type: string(models.TransactionTypeInvocation)}.IsInvocation())
+		assert.False(t, models.Transaction{Type: string(models.TransactionTypeContract)}.IsInvocation())
+	})
+
+	t.Run(".IsClaim()", func(t *testing.T) {
+		assert.True(t, models.Transaction{Type: string(models.TransactionTypeClaim)}.IsClaim())
+		assert.False(t, models.Transaction{Type: string(models.TransactionTypeMiner)}.IsClaim())
+	})
+
+	t.Run(".IsContract()", func(t *testing.T) {
+		assert.True(t, models.Transaction{Type: string(models.TransactionTypeContract)}.IsContract())
+		assert.False(t, models.Transaction{Type: "UnknownTransaction"}.IsContract())
+	})
+}
@@ -0,0 +1,11 @@
+package models
+
+type (
+	// TransferOutput holds a single output of a transfer, used when sending to many
+	// addresses in one transaction.
+	TransferOutput struct {
+		Asset   string `json:"asset"`
+		Value   Fixed8 `json:"value"`
+		Address string `json:"address"`
+	}
+)
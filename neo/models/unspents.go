@@ -0,0 +1,29 @@
+package models
+
+type (
+	// Unspents holds the unspent transaction outputs (UTXOs) of an address, grouped by
+	// asset. ScriptHash is the 0x prefixed, big-endian, script hash of the address.
+	Unspents struct {
+		Address    string           `json:"address"`
+		ScriptHash string           `json:"script_hash"`
+		Balances   []UnspentBalance `json:"balance"`
+	}
+
+	// UnspentBalance holds the unspent outputs of a single asset within Unspents, Amount is
+	// the sum of their values.
+	UnspentBalance struct {
+		AssetHash   string         `json:"asset_hash"`
+		Asset       string         `json:"asset"`
+		AssetSymbol string         `json:"asset_symbol"`
+		Amount      Fixed8         `json:"amount"`
+		Unspents    []UnspentEntry `json:"unspent"`
+	}
+
+	// UnspentEntry is a single unspent output, Index is the index of the output within
+	// the transaction.
+	UnspentEntry struct {
+		TransactionID string `json:"txid"`
+		Index         int64  `json:"n"`
+		Value         Fixed8 `json:"value"`
+	}
+)
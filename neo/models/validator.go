@@ -0,0 +1,11 @@
+package models
+
+type (
+	// Validator holds the data about a consensus node candidate, and the votes it has
+	// received.
+	Validator struct {
+		PublicKey string `json:"publickey"`
+		Votes     string `json:"votes"`
+		Active    bool   `json:"active"`
+	}
+)
@@ -0,0 +1,13 @@
+package models
+
+type (
+	// Version holds the version information of a NEO node. NEO2 nodes report their port
+	// in Port, while NEO3 nodes report it in TCPPort (and WSPort).
+	Version struct {
+		Port      int    `json:"port"`
+		TCPPort   int    `json:"tcpport"`
+		WSPort    int    `json:"wsport"`
+		Nonce     int64  `json:"nonce"`
+		UserAgent string `json:"useragent"`
+	}
+)
@@ -1,11 +1,12 @@
 package models
 
 type (
-	// Vout holds data about the transaction outputs.
+	// Vout holds data about the transaction outputs. Address is resolved by the node from
+	// the script hash of the output, and is set for both gettxout and block transactions.
 	Vout struct {
 		Address string `json:"Address"`
 		Asset   string `json:"Asset"`
 		N       int    `json:"N"`
-		Value   string `json:"Value"`
+		Value   Fixed8 `json:"Value"`
 	}
 )
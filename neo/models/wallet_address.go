@@ -0,0 +1,12 @@
+package models
+
+type (
+	// WalletAddress is an address held by the wallet open on the node. WatchOnly
+	// addresses have no private key in the wallet, so they cannot sign transactions.
+	WalletAddress struct {
+		Address   string `json:"address"`
+		HasKey    bool   `json:"haskey"`
+		Label     string `json:"label"`
+		WatchOnly bool   `json:"watchonly"`
+	}
+)
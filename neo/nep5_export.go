@@ -0,0 +1,263 @@
+package neo
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/lomocoin/neo-go-sdk/neo/models/response"
+)
+
+// The formats supported by ExportNEP5Transfers.
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatJSON = "json"
+)
+
+const (
+	// nep5TransferWindow is the time window of transfers requested at once, which matches
+	// the default range of the node.
+	nep5TransferWindow = 7 * 24 * time.Hour
+
+	// nep5TransferLimit is the number of transfers (sent or received) a node returns for
+	// one request by default, a window with as many is split as it may be truncated.
+	nep5TransferLimit = 1000
+)
+
+type (
+	// exportedNEP5Transfer is a row of ExportNEP5Transfers.
+	exportedNEP5Transfer struct {
+		Direction           string `json:"direction"`
+		Time                string `json:"time"`
+		AssetHash           string `json:"assetHash"`
+		TransferAddress     string `json:"transferAddress"`
+		Amount              string `json:"amount"`
+		BlockIndex          int64  `json:"blockIndex"`
+		TransferNotifyIndex int64  `json:"transferNotifyIndex"`
+		TransactionHash     string `json:"txHash"`
+	}
+)
+
+var nep5TransferCSVHeader = []string{
+	"direction", "time", "assetHash", "transferAddress", "amount", "blockIndex",
+	"transferNotifyIndex", "txHash",
+}
+
+// GetNEP5Transfers returns the NEP-5 transfers sent and received by the address between
+// start and end, inclusive, to the second. A zero start or end is left for the node to
+// default, which is the last 7 days, when only end is given the transfers of the 7 days
+// before it are returned. NEO2 nodes take and return timestamps in seconds, unlike the
+// milliseconds of NEO3 nodes. Nodes return at most 1000 transfers each way by
+// default, ExportNEP5Transfers pages through longer histories. This is only supported by
+// NEO2 nodes with the RpcNep5Tracker plugin installed.
+func (c Client) GetNEP5Transfers(address string, start, end time.Time) (*models.NEP5Transfers, error) {
+	if err := c.checkNetworkGeneration(NEO2); err != nil {
+		return nil, err
+	}
+
+	requestBodyParams := []interface{}{
+		address,
+	}
+	if start.IsZero() && !end.IsZero() {
+		// the node only takes an end after a start
+		start = end.Add(-nep5TransferWindow)
+	}
+
+	if !start.IsZero() {
+		requestBodyParams = append(requestBodyParams, start.Unix())
+
+		if !end.IsZero() {
+			requestBodyParams = append(requestBodyParams, end.Unix())
+		}
+	}
+	var resp response.NEP5Transfers
+
+	err := c.executeRequest("getnep5transfers", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// ExportNEP5Transfers writes the NEP-5 transfers sent and received by the address between
+// from and to, oldest first, to w as CSV (with a header row) or as a JSON array, see
+// ExportFormatCSV and ExportFormatJSON. The transfers are fetched a week at a time, and a
+// week with more transfers than the node returns at once is split into smaller windows,
+// so histories of any length are exported in constant memory. Each transfer is written
+// as soon as its window has been fetched.
+//
+// from must be set, as walking windows from the zero time would take tens of thousands of
+// calls. When a single second holds more transfers than the node returns at once, the
+// window cannot be split further and an error is returned rather than a truncated export.
+//
+// When ctx is done no further windows are fetched, the request in flight is aborted, and
+// ctx.Err() is returned. w may then hold a partial export.
+func (c Client) ExportNEP5Transfers(ctx context.Context, w io.Writer, address string, from, to time.Time, format string) error {
+	if from.IsZero() {
+		return errors.New("start of the export range must be set")
+	}
+
+	var write func(transfer exportedNEP5Transfer) error
+	var finish func() error
+
+	switch format {
+	case ExportFormatCSV:
+		csvWriter := csv.NewWriter(w)
+		if err := csvWriter.Write(nep5TransferCSVHeader); err != nil {
+			return err
+		}
+
+		write = func(transfer exportedNEP5Transfer) error {
+			return csvWriter.Write([]string{
+				transfer.Direction,
+				transfer.Time,
+				transfer.AssetHash,
+				transfer.TransferAddress,
+				transfer.Amount,
+				strconv.FormatInt(transfer.BlockIndex, 10),
+				strconv.FormatInt(transfer.TransferNotifyIndex, 10),
+				transfer.TransactionHash,
+			})
+		}
+		finish = func() error {
+			csvWriter.Flush()
+			return csvWriter.Error()
+		}
+	case ExportFormatJSON:
+		separator := "["
+
+		write = func(transfer exportedNEP5Transfer) error {
+			transferJSON, err := json.Marshal(transfer)
+			if err != nil {
+				return err
+			}
+
+			if _, err := io.WriteString(w, separator); err != nil {
+				return err
+			}
+			separator = ","
+
+			_, err = w.Write(transferJSON)
+			return err
+		}
+		finish = func() error {
+			if separator == "[" {
+				_, err := io.WriteString(w, "[]")
+				return err
+			}
+
+			_, err := io.WriteString(w, "]")
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported export format: '%s'", format)
+	}
+
+	c = c.WithContext(ctx)
+
+	err := c.forEachNEP5TransferWindow(ctx, address, from.Unix(), to.Unix(), func(transfers *models.NEP5Transfers) error {
+		for _, transfer := range sortedNEP5Transfers(transfers) {
+			if err := write(transfer); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return finish()
+}
+
+// forEachNEP5TransferWindow calls fn with the transfers of each window between from and
+// to, in seconds and inclusive, oldest first. A window holding as many transfers as the
+// node returns at once may have been truncated, so it is split in half and fetched again.
+func (c Client) forEachNEP5TransferWindow(ctx context.Context, address string, from, to int64, fn func(transfers *models.NEP5Transfers) error) error {
+	window := int64(nep5TransferWindow / time.Second)
+
+	for start := from; start <= to; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		end := start + window - 1
+		if end > to {
+			end = to
+		}
+
+		transfers, err := c.GetNEP5Transfers(address, time.Unix(start, 0), time.Unix(end, 0))
+		if err != nil {
+			return err
+		}
+
+		truncated := len(transfers.Sent) >= nep5TransferLimit || len(transfers.Received) >= nep5TransferLimit
+		if truncated {
+			if end == start {
+				return fmt.Errorf(
+					"at least %d transfers at %s, which cannot be fetched without truncation",
+					nep5TransferLimit, time.Unix(start, 0).UTC().Format(time.RFC3339),
+				)
+			}
+
+			window = (end - start + 1) / 2
+			continue
+		}
+
+		if err := fn(transfers); err != nil {
+			return err
+		}
+
+		start = end + 1
+		window = int64(nep5TransferWindow / time.Second)
+	}
+
+	return nil
+}
+
+// sortedNEP5Transfers returns the sent and received transfers as export rows, ordered by
+// block and then by notification.
+func sortedNEP5Transfers(transfers *models.NEP5Transfers) []exportedNEP5Transfer {
+	rows := make([]exportedNEP5Transfer, 0, len(transfers.Sent)+len(transfers.Received))
+
+	for direction, directionTransfers := range map[string][]models.NEP5Transfer{
+		"sent":     transfers.Sent,
+		"received": transfers.Received,
+	} {
+		for _, transfer := range directionTransfers {
+			rows = append(rows, exportedNEP5Transfer{
+				Direction:           direction,
+				Time:                time.Unix(transfer.Timestamp, 0).UTC().Format(time.RFC3339),
+				AssetHash:           transfer.AssetHash,
+				TransferAddress:     transfer.TransferAddress,
+				Amount:              transfer.Amount,
+				BlockIndex:          transfer.BlockIndex,
+				TransferNotifyIndex: transfer.TransferNotifyIndex,
+				TransactionHash:     transfer.TransactionHash,
+			})
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].BlockIndex != rows[j].BlockIndex {
+			return rows[i].BlockIndex < rows[j].BlockIndex
+		}
+
+		if rows[i].TransferNotifyIndex != rows[j].TransferNotifyIndex {
+			return rows[i].TransferNotifyIndex < rows[j].TransferNotifyIndex
+		}
+
+		return rows[i].Direction > rows[j].Direction
+	})
+
+	return rows
+}
@@ -0,0 +1,293 @@
+package neo_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// newNEP5TransferNode returns a NEO2 node holding count transfers a minute apart from
+// start, alternately sent and received. Like a real node, it returns at most 1000
+// transfers each way for a request.
+func newNEP5TransferNode(start time.Time, count int) *testNode {
+	return newTestNode(map[string]testHandler{
+		"getversion": testRawResult(`{"port": 10333, "useragent": "/NEO:2.10.3/"}`),
+		"getnep5transfers": func(params []json.RawMessage) (interface{}, *testRPCError) {
+			from, to := int64(0), int64(math.MaxInt64)
+			if len(params) > 1 {
+				_ = json.Unmarshal(params[1], &from)
+			}
+			if len(params) > 2 {
+				_ = json.Unmarshal(params[2], &to)
+			}
+
+			transfers := models.NEP5Transfers{
+				Address:  testAccounts[0].publicAddress,
+				Sent:     []models.NEP5Transfer{},
+				Received: []models.NEP5Transfer{},
+			}
+
+			for i := 0; i < count; i++ {
+				transfer := models.NEP5Transfer{
+					Timestamp:       start.Unix() + int64(i)*60,
+					AssetHash:       "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9",
+					TransferAddress: testAccounts[1].publicAddress,
+					Amount:          fmt.Sprint(i + 1),
+					BlockIndex:      int64(i),
+					TransactionHash: testChainHash(int64(i)),
+				}
+
+				if transfer.Timestamp < from || transfer.Timestamp > to {
+					continue
+				}
+
+				if i%2 == 0 && len(transfers.Sent) < 1000 {
+					transfers.Sent = append(transfers.Sent, transfer)
+				} else if i%2 == 1 && len(transfers.Received) < 1000 {
+					transfers.Received = append(transfers.Received, transfer)
+				}
+			}
+
+			return transfers, nil
+		},
+	})
+}
+
+func TestNEP5Export(t *testing.T) {
+	start := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run(".GetNEP5Transfers()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newNEP5TransferNode(start, 3)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			transfers, err := client.GetNEP5Transfers(testAccounts[0].publicAddress, start, start.Add(time.Hour))
+			assert.NoError(t, err)
+			assert.Len(t, transfers.Sent, 2)
+			assert.Len(t, transfers.Received, 1)
+			assert.Equal(t, start.Unix()+60, transfers.Received[0].Timestamp)
+			assert.Equal(t, "2", transfers.Received[0].Amount)
+
+			params := node.Calls("getnep5transfers")[0].Params
+			assert.Equal(t, fmt.Sprint(start.Unix()), string(params[1]))
+			assert.Equal(t, fmt.Sprint(start.Add(time.Hour).Unix()), string(params[2]))
+		})
+
+		t.Run("OptionalRange", func(t *testing.T) {
+			node := newNEP5TransferNode(start, 3)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetNEP5Transfers(testAccounts[0].publicAddress, time.Time{}, time.Time{})
+			assert.NoError(t, err)
+
+			_, err = client.GetNEP5Transfers(testAccounts[0].publicAddress, start, time.Time{})
+			assert.NoError(t, err)
+
+			end := start.Add(30 * 24 * time.Hour)
+			_, err = client.GetNEP5Transfers(testAccounts[0].publicAddress, time.Time{}, end)
+			assert.NoError(t, err)
+
+			calls := node.Calls("getnep5transfers")
+			assert.Len(t, calls, 3)
+			assert.Len(t, calls[0].Params, 1)
+			assert.Len(t, calls[1].Params, 2)
+			assert.Equal(t, fmt.Sprint(end.Add(-7*24*time.Hour).Unix()), string(calls[2].Params[1]))
+			assert.Equal(t, fmt.Sprint(end.Unix()), string(calls[2].Params[2]))
+		})
+
+		t.Run("NEO3Node", func(t *testing.T) {
+			client := neo.NewClient("http://localhost:10332", neo.WithNetworkGeneration(neo.NEO3))
+
+			_, err := client.GetNEP5Transfers(testAccounts[0].publicAddress, time.Time{}, time.Time{})
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, err)
+		})
+	})
+
+	t.Run(".ExportNEP5Transfers()", func(t *testing.T) {
+		t.Run("CSV", func(t *testing.T) {
+			node := newNEP5TransferNode(start, 3)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			var buffer bytes.Buffer
+			err := client.ExportNEP5Transfers(
+				context.Background(), &buffer, testAccounts[0].publicAddress,
+				start, start.Add(30*24*time.Hour), neo.ExportFormatCSV,
+			)
+			assert.NoError(t, err)
+
+			records, err := csv.NewReader(&buffer).ReadAll()
+			assert.NoError(t, err)
+			assert.Equal(t, [][]string{
+				{"direction", "time", "assetHash", "transferAddress", "amount", "blockIndex", "transferNotifyIndex", "txHash"},
+				{"sent", "2019-03-01T00:00:00Z", "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9", testAccounts[1].publicAddress, "1", "0", "0", testChainHash(0)},
+				{"received", "2019-03-01T00:01:00Z", "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9", testAccounts[1].publicAddress, "2", "1", "0", testChainHash(1)},
+				{"sent", "2019-03-01T00:02:00Z", "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9", testAccounts[1].publicAddress, "3", "2", "0", testChainHash(2)},
+			}, records)
+
+			// a 30 day range is fetched a week at a time
+			assert.Len(t, node.Calls("getnep5transfers"), 5)
+		})
+
+		t.Run("JSON", func(t *testing.T) {
+			node := newNEP5TransferNode(start, 2)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			var buffer bytes.Buffer
+			err := client.ExportNEP5Transfers(
+				context.Background(), &buffer, testAccounts[0].publicAddress,
+				start, start.Add(time.Hour), neo.ExportFormatJSON,
+			)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `[
+				{
+					"direction": "sent",
+					"time": "2019-03-01T00:00:00Z",
+					"assetHash": "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9",
+					"transferAddress": "`+testAccounts[1].publicAddress+`",
+					"amount": "1",
+					"blockIndex": 0,
+					"transferNotifyIndex": 0,
+					"txHash": "`+testChainHash(0)+`"
+				},
+				{
+					"direction": "received",
+					"time": "2019-03-01T00:01:00Z",
+					"assetHash": "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9",
+					"transferAddress": "`+testAccounts[1].publicAddress+`",
+					"amount": "2",
+					"blockIndex": 1,
+					"transferNotifyIndex": 0,
+					"txHash": "`+testChainHash(1)+`"
+				}
+			]`, buffer.String())
+		})
+
+		t.Run("NoTransfers", func(t *testing.T) {
+			node := newNEP5TransferNode(start, 0)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			var buffer bytes.Buffer
+			err := client.ExportNEP5Transfers(
+				context.Background(), &buffer, testAccounts[0].publicAddress,
+				start, start.Add(time.Hour), neo.ExportFormatJSON,
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, "[]", buffer.String())
+		})
+
+		t.Run("LongHistory", func(t *testing.T) {
+			node := newNEP5TransferNode(start, 2500)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			var buffer bytes.Buffer
+			err := client.ExportNEP5Transfers(
+				context.Background(), &buffer, testAccounts[0].publicAddress,
+				start, start.Add(7*24*time.Hour), neo.ExportFormatCSV,
+			)
+			assert.NoError(t, err)
+
+			records, err := csv.NewReader(&buffer).ReadAll()
+			assert.NoError(t, err)
+			assert.Len(t, records, 2501)
+
+			for i, record := range records[1:] {
+				assert.Equal(t, fmt.Sprint(i+1), record[4])
+			}
+		})
+
+		t.Run("TooManyTransfersInOneSecond", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": testRawResult(`{"port": 10333, "useragent": "/NEO:2.10.3/"}`),
+				"getnep5transfers": func([]json.RawMessage) (interface{}, *testRPCError) {
+					transfers := models.NEP5Transfers{Received: []models.NEP5Transfer{}}
+					for i := 0; i < 1000; i++ {
+						transfers.Sent = append(transfers.Sent, models.NEP5Transfer{Timestamp: start.Unix()})
+					}
+
+					return transfers, nil
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			var buffer bytes.Buffer
+			err := client.ExportNEP5Transfers(
+				context.Background(), &buffer, testAccounts[0].publicAddress,
+				start, start.Add(time.Hour), neo.ExportFormatCSV,
+			)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "2019-03-01T00:00:00Z")
+		})
+
+		t.Run("ZeroFrom", func(t *testing.T) {
+			node := newNEP5TransferNode(start, 1)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			var buffer bytes.Buffer
+			err := client.ExportNEP5Transfers(
+				context.Background(), &buffer, testAccounts[0].publicAddress,
+				time.Time{}, start, neo.ExportFormatCSV,
+			)
+			assert.Error(t, err)
+			assert.Empty(t, node.Calls("getnep5transfers"))
+			assert.Empty(t, buffer.String())
+		})
+
+		t.Run("UnsupportedFormat", func(t *testing.T) {
+			node := newNEP5TransferNode(start, 1)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			var buffer bytes.Buffer
+			err := client.ExportNEP5Transfers(
+				context.Background(), &buffer, testAccounts[0].publicAddress,
+				start, start.Add(time.Hour), "xml",
+			)
+			assert.Error(t, err)
+			assert.Empty(t, node.Calls("getnep5transfers"))
+		})
+
+		t.Run("CancelledContext", func(t *testing.T) {
+			node := newNEP5TransferNode(start, 1)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			var buffer bytes.Buffer
+			err := client.ExportNEP5Transfers(
+				ctx, &buffer, testAccounts[0].publicAddress,
+				start, start.Add(time.Hour), neo.ExportFormatCSV,
+			)
+			assert.Equal(t, context.Canceled, err)
+			assert.Empty(t, node.Calls("getnep5transfers"))
+		})
+	})
+}
@@ -0,0 +1,279 @@
+package neo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+type (
+	// NetworkGeneration is the generation of the NEO network (NEO2 or NEO3) that the node
+	// belongs to. The generations differ in:
+	//
+	//   - address encoding: NEO2 addresses start with "A" (version byte 0x17) and NEO3
+	//     addresses start with "N" (version byte 0x35), the verification script which is
+	//     hashed also differs.
+	//   - token methods: NEO2 nodes track NEP-5 tokens while NEO3 nodes track NEP-17
+	//     tokens, the NEP-17 methods return ErrUnsupportedNetworkGeneration on NEO2 nodes.
+	//   - response shapes: NEP-17 models carry the symbol and decimals of each token, and
+	//     byte array stack items are base64 rather than hex encoded, see StackEncoding.
+	NetworkGeneration int
+
+	// NodeVersion holds the software version of a node, as negotiated by Negotiate, and
+	// the network generation the Client uses for it.
+	NodeVersion struct {
+		UserAgent       string
+		SoftwareVersion string
+		Generation      NetworkGeneration
+	}
+
+	// networkDetection holds the network generation set with WithNetworkGeneration, and
+	// caches the negotiated version of each node. It is shared by copies of the Client
+	// which created it, so all access is guarded by the mutex.
+	networkDetection struct {
+		mutex      sync.Mutex
+		generation NetworkGeneration
+		nodes      map[string]NodeVersion
+	}
+)
+
+const (
+	// NEO2 is the legacy NEO network, with UTXO based global assets and NEP-5 tokens.
+	NEO2 NetworkGeneration = 2
+
+	// NEO3 is the NEO N3 network, where all assets are NEP-17 tokens.
+	NEO3 NetworkGeneration = 3
+)
+
+// ErrUnsupportedNetworkGeneration is returned by methods which are only supported by one
+// network generation, when the node belongs to the other one.
+var ErrUnsupportedNetworkGeneration = errors.New("method is not supported by the node's network generation")
+
+// String implements the fmt.Stringer interface.
+func (g NetworkGeneration) String() string {
+	switch g {
+	case NEO2:
+		return "NEO2"
+	case NEO3:
+		return "NEO3"
+	}
+
+	return "unknown"
+}
+
+// StackEncoding returns the encoding of byte array stack items returned by nodes of the
+// network generation, which is set as the Encoding of a models.StackItem.
+func (g NetworkGeneration) StackEncoding() models.ByteEncoding {
+	if g == NEO3 {
+		return models.ByteEncodingBase64
+	}
+
+	return models.ByteEncodingHex
+}
+
+// addressVersion returns the version byte of addresses on the network generation.
+func (g NetworkGeneration) addressVersion() byte {
+	if g == NEO3 {
+		return 0x35
+	}
+
+	return DefaultAddressVersion
+}
+
+// verificationScript returns the script which checks a signature of the public key,
+// whose hash is used for the address of the public key.
+func (g NetworkGeneration) verificationScript(publicKey []byte) []byte {
+	if g == NEO3 {
+		// PUSHDATA1 <public key> SYSCALL System.Crypto.CheckSig
+		script := append([]byte{0x0C, 0x21}, publicKey...)
+		return append(script, 0x41, 0x56, 0xE7, 0xB3, 0x27)
+	}
+
+	// PUSHBYTES33 <public key> CHECKSIG
+	script := append([]byte{0x21}, publicKey...)
+	return append(script, 0xAC)
+}
+
+// NetworkGeneration returns the network generation of the node. When the Client was
+// created with WithNetworkGeneration that generation is returned, otherwise the node is
+// negotiated with the first time it is needed, see Negotiate.
+func (c Client) NetworkGeneration() (NetworkGeneration, error) {
+	if generation := c.network.configured(); generation != 0 {
+		return generation, nil
+	}
+
+	if version, ok := c.network.node(c.Node); ok {
+		return version.Generation, nil
+	}
+
+	version, err := c.negotiate()
+	if err != nil {
+		return 0, err
+	}
+
+	return version.Generation, nil
+}
+
+// Negotiate calls getversion on the node and records its software version, which is
+// cached per node URI. The network generation worked out from it decides how responses
+// are decoded, such as hex or base64 byte arrays, and which token methods (NEP-5 or
+// NEP-17) are supported, so that a Client works across nodes of both generations.
+//
+// Negotiation happens lazily the first time the network generation of a node is needed,
+// Negotiate can be called to do it up front, or again after a node has been upgraded.
+// The request is sent with ctx, see WithContext.
+func (c Client) Negotiate(ctx context.Context) (NodeVersion, error) {
+	if err := ctx.Err(); err != nil {
+		return NodeVersion{}, err
+	}
+
+	return c.WithContext(ctx).negotiate()
+}
+
+func (c Client) negotiate() (NodeVersion, error) {
+	version, err := c.GetVersion()
+	if err != nil {
+		return NodeVersion{}, err
+	}
+
+	nodeVersion := NodeVersion{
+		UserAgent:       version.UserAgent,
+		SoftwareVersion: softwareVersionOf(version.UserAgent),
+		Generation:      c.network.configured(),
+	}
+
+	if nodeVersion.Generation == 0 {
+		nodeVersion.Generation, err = networkGenerationOf(version)
+		if err != nil {
+			return nodeVersion, err
+		}
+	}
+
+	c.network.setNode(c.Node, nodeVersion)
+	return nodeVersion, nil
+}
+
+// configured returns the network generation set with WithNetworkGeneration, or 0.
+func (n *networkDetection) configured() NetworkGeneration {
+	if n == nil {
+		return 0
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	return n.generation
+}
+
+// node returns the negotiated version of the node, if it has been negotiated with.
+func (n *networkDetection) node(nodeURI string) (NodeVersion, bool) {
+	if n == nil {
+		return NodeVersion{}, false
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	version, ok := n.nodes[nodeURI]
+	return version, ok
+}
+
+func (n *networkDetection) setNode(nodeURI string, version NodeVersion) {
+	if n == nil {
+		return
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if n.nodes == nil {
+		n.nodes = map[string]NodeVersion{}
+	}
+
+	n.nodes[nodeURI] = version
+}
+
+// AddressVersion returns the version byte of addresses on the node's network. When the
+// Client was created with WithAddressVersion that version is returned, otherwise it is
+// the standard version of the network generation. It can be passed to the offline
+// address functions, such as ScriptHashToAddress.
+func (c Client) AddressVersion() (byte, error) {
+	if c.addressVersion != 0 {
+		return c.addressVersion, nil
+	}
+
+	generation, err := c.NetworkGeneration()
+	if err != nil {
+		return 0, err
+	}
+
+	return generation.addressVersion(), nil
+}
+
+// PublicAddress derives the address of the private key on the node's network generation,
+// using the version byte returned by AddressVersion.
+func (c Client) PublicAddress(privateKey PrivateKey) (string, error) {
+	generation, err := c.NetworkGeneration()
+	if err != nil {
+		return "", err
+	}
+
+	version, err := c.AddressVersion()
+	if err != nil {
+		return "", err
+	}
+
+	return privateKey.publicAddress(generation, version)
+}
+
+// checkNetworkGeneration returns ErrUnsupportedNetworkGeneration if the node is known to
+// belong to a network generation other than the one given. When the generation cannot be
+// detected the call is left to the node to accept or reject.
+func (c Client) checkNetworkGeneration(generation NetworkGeneration) error {
+	nodeGeneration, err := c.NetworkGeneration()
+	if err != nil || nodeGeneration == generation {
+		return nil
+	}
+
+	return ErrUnsupportedNetworkGeneration
+}
+
+// networkGenerationOf works out the network generation from a getversion response. The
+// user agent of the C# node ("/NEO:2.10.3/" or "/Neo:3.0.0/") gives the major version,
+// failing that NEO3 nodes report "tcpport" where NEO2 nodes report "port".
+func networkGenerationOf(version *models.Version) (NetworkGeneration, error) {
+	userAgent := strings.ToLower(version.UserAgent)
+
+	if i := strings.Index(userAgent, "/neo:"); i != -1 && len(userAgent) > i+5 {
+		switch userAgent[i+5] {
+		case '2':
+			return NEO2, nil
+		case '3':
+			return NEO3, nil
+		}
+	}
+
+	if version.TCPPort != 0 {
+		return NEO3, nil
+	}
+
+	if version.Port != 0 {
+		return NEO2, nil
+	}
+
+	return 0, errors.New("Unable to detect network generation from node version")
+}
+
+// softwareVersionOf returns the version within the user agent of a node, such as "2.10.3"
+// for "/NEO:2.10.3/", or the whole user agent when it is in another format.
+func softwareVersionOf(userAgent string) string {
+	version := strings.Trim(userAgent, "/")
+	if i := strings.LastIndex(version, ":"); i != -1 {
+		version = version[i+1:]
+	}
+
+	return version
+}
@@ -0,0 +1,279 @@
+package neo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetwork(t *testing.T) {
+	neo2Version := `{"port": 10333, "nonce": 1156529325, "useragent": "/NEO:2.10.3/"}`
+	neo3Version := `{"tcpport": 10333, "wsport": 10334, "nonce": 1156529325, "useragent": "/Neo:3.0.3/"}`
+
+	t.Run(".NetworkGeneration()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			for version, expected := range map[string]neo.NetworkGeneration{
+				neo2Version: neo.NEO2,
+				neo3Version: neo.NEO3,
+				`{"tcpport": 10333, "useragent": "/NEO-GO:0.97.0/"}`: neo.NEO3,
+				`{"port": 10333, "useragent": "/NEO-GO:0.78.0/"}`:    neo.NEO2,
+			} {
+				node := newTestNode(map[string]testHandler{
+					"getversion": testRawResult(version),
+				})
+
+				client := neo.NewClient(node.URL)
+
+				generation, err := client.NetworkGeneration()
+				assert.NoError(t, err)
+				assert.Equal(t, expected, generation)
+
+				node.Close()
+			}
+		})
+
+		t.Run("CachesDetection", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": testRawResult(neo3Version),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			for i := 0; i < 3; i++ {
+				generation, err := client.NetworkGeneration()
+				assert.NoError(t, err)
+				assert.Equal(t, neo.NEO3, generation)
+			}
+
+			assert.Len(t, node.Calls("getversion"), 1)
+			assert.Equal(t, "NEO3", client.Config().NetworkGeneration)
+		})
+
+		t.Run("WithNetworkGeneration", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": testRawResult(neo3Version),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			generation, err := client.NetworkGeneration()
+			assert.NoError(t, err)
+			assert.Equal(t, neo.NEO2, generation)
+			assert.Empty(t, node.Calls("getversion"))
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": testRawResult(`{"nonce": 1156529325, "useragent": "/Unknown:1.0/"}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.NetworkGeneration()
+			assert.Error(t, err)
+			assert.Equal(t, "auto", client.Config().NetworkGeneration)
+		})
+	})
+
+	t.Run(".Negotiate()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": testRawResult(neo2Version),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			version, err := client.Negotiate(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, neo.NodeVersion{
+				UserAgent:       "/NEO:2.10.3/",
+				SoftwareVersion: "2.10.3",
+				Generation:      neo.NEO2,
+			}, version)
+
+			generation, err := client.NetworkGeneration()
+			assert.NoError(t, err)
+			assert.Equal(t, neo.NEO2, generation)
+			assert.Len(t, node.Calls("getversion"), 1)
+			assert.Equal(t, "2.10.3", client.NodeStatuses()[0].SoftwareVersion)
+		})
+
+		t.Run("PerNode", func(t *testing.T) {
+			neo2Node := newTestNode(map[string]testHandler{
+				"getblockcount": testResult(100),
+				"getversion":    testRawResult(neo2Version),
+			})
+			defer neo2Node.Close()
+
+			neo3Node := newTestNode(map[string]testHandler{
+				"getblockcount": testResult(90),
+				"getversion":    testRawResult(neo3Version),
+			})
+			defer neo3Node.Close()
+
+			client, err := neo.NewClientUsingMultipleNodes([]string{neo2Node.URL, neo3Node.URL})
+			assert.NoError(t, err)
+
+			generation, err := client.NetworkGeneration()
+			assert.NoError(t, err)
+			assert.Equal(t, neo.NEO2, generation)
+
+			client.Node = neo3Node.URL
+
+			for i := 0; i < 2; i++ {
+				generation, err = client.NetworkGeneration()
+				assert.NoError(t, err)
+				assert.Equal(t, neo.NEO3, generation)
+			}
+
+			assert.Len(t, neo2Node.Calls("getversion"), 1)
+			assert.Len(t, neo3Node.Calls("getversion"), 1)
+
+			statuses := client.NodeStatuses()
+			assert.Equal(t, "2.10.3", statuses[0].SoftwareVersion)
+			assert.Equal(t, "3.0.3", statuses[1].SoftwareVersion)
+		})
+
+		t.Run("WithNetworkGeneration", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": testRawResult(`{"tcpport": 10333, "useragent": "/NEO-GO:0.97.0/"}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			version, err := client.Negotiate(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "0.97.0", version.SoftwareVersion)
+			assert.Equal(t, neo.NEO2, version.Generation)
+		})
+
+		t.Run("CancelledContext", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": testRawResult(neo2Version),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := client.Negotiate(ctx)
+			assert.Equal(t, context.Canceled, err)
+			assert.Empty(t, node.Calls("getversion"))
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.Negotiate(context.Background())
+			assert.Error(t, err)
+			assert.Empty(t, client.NodeStatuses()[0].SoftwareVersion)
+		})
+	})
+
+	t.Run(".StackEncoding()", func(t *testing.T) {
+		assert.Equal(t, models.ByteEncodingHex, neo.NEO2.StackEncoding())
+		assert.Equal(t, models.ByteEncodingBase64, neo.NEO3.StackEncoding())
+	})
+
+	t.Run(".PublicAddress()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			privateKey, err := neo.NewPrivateKeyFromWIF(testAccounts[0].wif)
+			assert.NoError(t, err)
+
+			for generation, expected := range map[neo.NetworkGeneration]string{
+				neo.NEO2: testAccounts[0].publicAddress,
+				neo.NEO3: "NPTmAHDxo6Pkyic8Nvu3kwyXoYJCvcCB6i",
+			} {
+				client := neo.NewClient("http://localhost:10332", neo.WithNetworkGeneration(generation))
+
+				address, err := client.PublicAddress(*privateKey)
+				assert.NoError(t, err)
+				assert.Equal(t, expected, address)
+			}
+		})
+
+		t.Run("WithAddressVersion", func(t *testing.T) {
+			privateKey, err := neo.NewPrivateKeyFromWIF(testAccounts[0].wif)
+			assert.NoError(t, err)
+
+			client := neo.NewClient(
+				"http://localhost:10332",
+				neo.WithNetworkGeneration(neo.NEO2),
+				neo.WithAddressVersion(0x42),
+			)
+
+			address, err := client.PublicAddress(*privateKey)
+			assert.NoError(t, err)
+			assert.Equal(t, "TeN3WBg5hfa3GQJ6tnQx8hScvvsx6EDGPA", address)
+		})
+	})
+
+	t.Run(".AddressVersion()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			for generation, expected := range map[neo.NetworkGeneration]byte{
+				neo.NEO2: neo.DefaultAddressVersion,
+				neo.NEO3: 0x35,
+			} {
+				client := neo.NewClient("http://localhost:10332", neo.WithNetworkGeneration(generation))
+
+				version, err := client.AddressVersion()
+				assert.NoError(t, err)
+				assert.Equal(t, expected, version)
+				assert.Equal(t, expected, client.Config().AddressVersion)
+			}
+		})
+
+		t.Run("WithAddressVersion", func(t *testing.T) {
+			client := neo.NewClient("http://localhost:10332", neo.WithAddressVersion(0x42))
+
+			version, err := client.AddressVersion()
+			assert.NoError(t, err)
+			assert.Equal(t, byte(0x42), version)
+			assert.Equal(t, byte(0x42), client.Config().AddressVersion)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.AddressVersion()
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run(".GetNEP17Balances()", func(t *testing.T) {
+		t.Run("NEO2Node", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": testRawResult(neo2Version),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetNEP17Balances(testAccounts[0].publicAddress)
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, err)
+
+			_, err = client.GetNEP17Transfers(testAccounts[0].publicAddress, time.Time{}, time.Time{})
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, err)
+
+			assert.Empty(t, node.Calls("getnep17balances"))
+			assert.Empty(t, node.Calls("getnep17transfers"))
+		})
+	})
+}
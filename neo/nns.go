@@ -0,0 +1,53 @@
+package neo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+// DefaultNNSContract is the script hash of the Neo Name Service contract on the NEO3
+// MainNet, used by ResolveName unless WithNNSContract is used.
+const DefaultNNSContract = "0x50ac1c37690cc2cfc594472833cf57505d5f46de"
+
+// nnsRecordTypeTXT is the NNS record type holding free text, which is where names
+// store the address they map to.
+const nnsRecordTypeTXT = 16
+
+// ErrNameNotFound is returned by ResolveName when the name is not registered, or has no
+// record.
+var ErrNameNotFound = errors.New("name is not registered with the name service")
+
+// ResolveName resolves a Neo Name Service domain, such as "alice.neo", to the address (or
+// other text) held in its TXT record. It test invokes the resolve method of the NNS
+// contract, which is DefaultNNSContract unless the Client was created with
+// WithNNSContract. ErrNameNotFound is returned when the name is not registered or has no
+// TXT record.
+func (c Client) ResolveName(domain string) (string, error) {
+	name := strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	contract := c.nnsContract
+	if contract == "" {
+		contract = DefaultNNSContract
+	}
+
+	result, err := c.InvokeFunction(contract, "resolve", []models.Parameter{
+		{Type: models.ParameterTypeString, Value: name},
+		{Type: models.ParameterTypeInteger, Value: nnsRecordTypeTXT},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if strings.Contains(result.State, "FAULT") || len(result.Stack) == 0 {
+		return "", ErrNameNotFound
+	}
+
+	record := result.Stack[0]
+	if record.Type == "Any" {
+		return "", ErrNameNotFound
+	}
+
+	return record.AsString()
+}
@@ -0,0 +1,99 @@
+package neo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNNS(t *testing.T) {
+	neo3Version := testRawResult(`{"tcpport": 10333, "useragent": "/Neo:3.0.3/"}`)
+
+	t.Run(".ResolveName()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": neo3Version,
+				"invokefunction": testRawResult(`{
+					"state": "HALT",
+					"gasconsumed": "2028330",
+					"exception": null,
+					"stack": [
+						{"type": "ByteString", "value": "TlBUbUFIRHhvNlBreWljOE52dTNrd3lYb1lKQ3ZjQ0I2aQ=="}
+					]
+				}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			address, err := client.ResolveName("Alice.neo.")
+			assert.NoError(t, err)
+			assert.Equal(t, "NPTmAHDxo6Pkyic8Nvu3kwyXoYJCvcCB6i", address)
+
+			params := node.Calls("invokefunction")[0].Params
+			assert.JSONEq(t, `"`+neo.DefaultNNSContract+`"`, string(params[0]))
+			assert.JSONEq(t, `"resolve"`, string(params[1]))
+			assert.JSONEq(t, `[
+				{"type": "String", "value": "alice.neo"},
+				{"type": "Integer", "value": "16"}
+			]`, string(params[2]))
+		})
+
+		t.Run("WithNNSContract", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": neo3Version,
+				"invokefunction": testRawResult(`{
+					"state": "HALT",
+					"stack": [{"type": "ByteString", "value": "bmVv"}]
+				}`),
+			})
+			defer node.Close()
+
+			contract := "0x1a70eac53f5882e40dd90f55463cce31a9f72cd4"
+			client := neo.NewClient(node.URL, neo.WithNNSContract(contract))
+
+			_, err := client.ResolveName("alice.neo")
+			assert.NoError(t, err)
+			assert.Equal(t, contract, client.Config().NNSContract)
+
+			params := node.Calls("invokefunction")[0].Params
+			assert.JSONEq(t, `"`+contract+`"`, string(params[0]))
+		})
+
+		t.Run("NotFound", func(t *testing.T) {
+			for _, result := range []string{
+				`{"state": "FAULT", "exception": "The name does not exist.", "stack": []}`,
+				`{"state": "HALT", "stack": [{"type": "Any"}]}`,
+			} {
+				node := newTestNode(map[string]testHandler{
+					"getversion":     neo3Version,
+					"invokefunction": testRawResult(result),
+				})
+
+				client := neo.NewClient(node.URL)
+
+				address, err := client.ResolveName("bob.neo")
+				assert.Equal(t, neo.ErrNameNotFound, err)
+				assert.Empty(t, address)
+
+				node.Close()
+			}
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"invokefunction": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -32602, Message: "Invalid params"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.ResolveName("alice.neo")
+			assert.Equal(t, neo.RPCError{Code: -32602, Message: "Invalid params"}, err)
+		})
+	})
+}
@@ -0,0 +1,32 @@
+package neo
+
+import "sync"
+
+type (
+	// nodeChangeNotifier tracks the node which last served requests and calls fn when it
+	// changes. It is shared by copies of the Client which created it, so all access is
+	// guarded by the mutex.
+	nodeChangeNotifier struct {
+		mutex   sync.Mutex
+		current string
+		fn      func(old, new string)
+	}
+)
+
+// notify records that nodeURI is the active node, calling fn if it was not already. The
+// first node recorded is taken as the initial node and does not call fn. fn is called
+// after the mutex is released, so it may call back into the Client.
+func (n *nodeChangeNotifier) notify(nodeURI string) {
+	if n == nil {
+		return
+	}
+
+	n.mutex.Lock()
+	old := n.current
+	n.current = nodeURI
+	n.mutex.Unlock()
+
+	if old != "" && old != nodeURI {
+		n.fn(old, nodeURI)
+	}
+}
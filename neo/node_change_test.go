@@ -0,0 +1,152 @@
+package neo_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeChange(t *testing.T) {
+	type change struct {
+		old, new string
+	}
+
+	newRecorder := func() (func(old, new string), func() []change) {
+		var mutex sync.Mutex
+		var changes []change
+
+		record := func(old, new string) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			changes = append(changes, change{old: old, new: new})
+		}
+
+		recorded := func() []change {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return append([]change(nil), changes...)
+		}
+
+		return record, recorded
+	}
+
+	t.Run("SelectBestNode", func(t *testing.T) {
+		var secondHeight int64 = 90
+
+		first := newTestNode(map[string]testHandler{
+			"getblockcount": testResult(100),
+		})
+		defer first.Close()
+
+		second := newTestNode(map[string]testHandler{
+			"getblockcount": func([]json.RawMessage) (interface{}, *testRPCError) {
+				return atomic.LoadInt64(&secondHeight), nil
+			},
+		})
+		defer second.Close()
+
+		record, recorded := newRecorder()
+
+		client, err := neo.NewClientUsingMultipleNodes(
+			[]string{first.URL, second.URL},
+			neo.OnNodeChange(record),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, first.URL, client.Node)
+		assert.Empty(t, recorded())
+
+		assert.NoError(t, client.SelectBestNode())
+		assert.Empty(t, recorded())
+
+		atomic.StoreInt64(&secondHeight, 200)
+
+		assert.NoError(t, client.SelectBestNode())
+		assert.Equal(t, second.URL, client.Node)
+		assert.Equal(t, []change{{old: first.URL, new: second.URL}}, recorded())
+		assert.True(t, client.Config().NodeChangeCallback)
+	})
+
+	t.Run("Failover", func(t *testing.T) {
+		var failing int32
+
+		flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.LoadInt32(&failing) == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"id": 1, "jsonrpc": "2.0", "result": 200}`))
+		}))
+		defer flaky.Close()
+
+		healthy := newTestNode(map[string]testHandler{
+			"getblockcount": testResult(100),
+		})
+		defer healthy.Close()
+
+		var client *neo.Client
+		var statuses []neo.NodeStatus
+		record, recorded := newRecorder()
+
+		client, err := neo.NewClientUsingMultipleNodes(
+			[]string{flaky.URL, healthy.URL},
+			neo.WithCircuitBreaker(1, 20*time.Millisecond),
+			neo.OnNodeChange(func(old, new string) {
+				// the Client can be used from within the callback
+				statuses = client.NodeStatuses()
+				record(old, new)
+			}),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, flaky.URL, client.Node)
+
+		atomic.StoreInt32(&failing, 1)
+
+		_, err = client.GetBlockCount()
+		assert.NoError(t, err)
+		assert.Equal(t, []change{{old: flaky.URL, new: healthy.URL}}, recorded())
+		assert.Len(t, statuses, 2)
+
+		atomic.StoreInt32(&failing, 0)
+		time.Sleep(30 * time.Millisecond)
+
+		_, err = client.GetBlockCount()
+		assert.NoError(t, err)
+		assert.Equal(t, []change{
+			{old: flaky.URL, new: healthy.URL},
+			{old: healthy.URL, new: flaky.URL},
+		}, recorded())
+	})
+
+	t.Run("NilCallback", func(t *testing.T) {
+		var secondHeight int64 = 90
+
+		first := newTestNode(map[string]testHandler{
+			"getblockcount": testResult(100),
+		})
+		defer first.Close()
+
+		second := newTestNode(map[string]testHandler{
+			"getblockcount": func([]json.RawMessage) (interface{}, *testRPCError) {
+				return atomic.LoadInt64(&secondHeight), nil
+			},
+		})
+		defer second.Close()
+
+		client, err := neo.NewClientUsingMultipleNodes([]string{first.URL, second.URL}, neo.OnNodeChange(nil))
+		assert.NoError(t, err)
+		assert.False(t, client.Config().NodeChangeCallback)
+
+		atomic.StoreInt64(&secondHeight, 200)
+
+		assert.NotPanics(t, func() { assert.NoError(t, client.SelectBestNode()) })
+		assert.Equal(t, second.URL, client.Node)
+	})
+}
@@ -0,0 +1,89 @@
+package neo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+)
+
+// VerifyNodeHonesty checks that the Client's node serves the blocks it claims to have, as
+// a sanity check of an untrusted public node. The node's block count is fetched, then
+// sampleCount blocks are fetched: its highest claimed block, which a node reporting a
+// height it does not have cannot serve, and others picked at random below it. A block
+// passes when the node returns it with the requested index and a well formed hash.
+//
+// When a sampled block is missing or malformed false is returned with a BlockError giving
+// its index and the problem. Other errors, such as the node being unreachable, are
+// returned as is, as is ctx.Err() once ctx is done. It only shows the node has the
+// blocks, not that they are the blocks of the real chain, for that compare block hashes
+// with other nodes.
+//
+// The samples are always read from the Client's node, even with WithCircuitBreaker.
+func (c Client) VerifyNodeHonesty(ctx context.Context, sampleCount int) (bool, error) {
+	if sampleCount < 1 {
+		return false, fmt.Errorf("at least 1 block must be sampled, got: %d", sampleCount)
+	}
+
+	// every sample is read from the node under test, never failed over to another node
+	c = c.forNode(c.Node).WithContext(ctx)
+
+	blockCount, err := c.GetBlockCount()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return false, ctxErr
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if blockCount < 1 {
+		return false, errors.New("node claims to have no blocks")
+	}
+
+	for _, index := range sampleBlockIndexes(blockCount, sampleCount) {
+		block, err := c.GetBlockByIndex(index)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return false, ctxErr
+		}
+		if err != nil {
+			if _, ok := err.(RPCError); ok {
+				return false, BlockError{Index: index, Err: err}
+			}
+
+			return false, err
+		}
+
+		if block.Index != index {
+			return false, BlockError{Index: index, Err: fmt.Errorf("node returned block %d instead", block.Index)}
+		}
+
+		if _, ok := normalizeTxHash(block.Hash); !ok {
+			return false, BlockError{Index: index, Err: fmt.Errorf("block hash is not 32 bytes of hex, got: '%s'", block.Hash)}
+		}
+	}
+
+	return true, nil
+}
+
+// sampleBlockIndexes returns the index of the highest of blockCount blocks followed by
+// distinct random indexes below it, count indexes in all or every index when there are
+// fewer blocks.
+func sampleBlockIndexes(blockCount int64, count int) []int64 {
+	if int64(count) > blockCount {
+		count = int(blockCount)
+	}
+
+	highest := blockCount - 1
+	indexes := []int64{highest}
+	seen := map[int64]bool{highest: true}
+
+	for len(indexes) < count {
+		index := rand.Int63n(highest)
+		if !seen[index] {
+			seen[index] = true
+			indexes = append(indexes, index)
+		}
+	}
+
+	return indexes
+}
@@ -0,0 +1,150 @@
+package neo_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeHonesty(t *testing.T) {
+	t.Run(".VerifyNodeHonesty()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblockcount": testResult(1000),
+				"getblock":      blockHandler(-1),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			honest, err := client.VerifyNodeHonesty(context.Background(), 5)
+			assert.NoError(t, err)
+			assert.True(t, honest)
+
+			calls := node.Calls("getblock")
+			assert.Len(t, calls, 5)
+			assert.Equal(t, "999", string(calls[0].Params[0]))
+
+			seen := map[string]bool{}
+			for _, call := range calls {
+				seen[string(call.Params[0])] = true
+			}
+			assert.Len(t, seen, 5)
+		})
+
+		t.Run("ShortChain", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblockcount": testResult(3),
+				"getblock":      blockHandler(-1),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			honest, err := client.VerifyNodeHonesty(context.Background(), 10)
+			assert.NoError(t, err)
+			assert.True(t, honest)
+			assert.Len(t, node.Calls("getblock"), 3)
+		})
+
+		t.Run("MissingBlock", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblockcount": testResult(1000),
+				"getblock":      blockHandler(999),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			honest, err := client.VerifyNodeHonesty(context.Background(), 5)
+			assert.False(t, honest)
+			assert.Equal(t, neo.BlockError{
+				Index: 999,
+				Err:   neo.RPCError{Code: -100, Message: "Unknown block"},
+			}, err)
+		})
+
+		t.Run("MalformedBlock", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblockcount": testResult(1000),
+				"getblock": func(params []json.RawMessage) (interface{}, *testRPCError) {
+					return map[string]interface{}{"index": 0, "hash": "0x01"}, nil
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			honest, err := client.VerifyNodeHonesty(context.Background(), 5)
+			assert.False(t, honest)
+
+			var blockErr neo.BlockError
+			assert.True(t, errors.As(err, &blockErr))
+			assert.Equal(t, int64(999), blockErr.Index)
+		})
+
+		t.Run("CircuitBreaker", func(t *testing.T) {
+			node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+			}))
+			defer node.Close()
+
+			honestNode := newTestNode(map[string]testHandler{
+				"getblockcount": testResult(1000),
+				"getblock":      blockHandler(-1),
+			})
+			defer honestNode.Close()
+
+			client, err := neo.NewClientUsingMultipleNodes(
+				[]string{node.URL, honestNode.URL},
+				neo.WithCircuitBreaker(5, time.Minute),
+			)
+			assert.NoError(t, err)
+			client.Node = node.URL
+
+			honest, err := client.VerifyNodeHonesty(context.Background(), 5)
+			assert.False(t, honest)
+			assert.Error(t, err)
+			assert.Empty(t, honestNode.Calls("getblock"))
+		})
+
+		t.Run("Cancelled", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblockcount": testResult(1000),
+				"getblock":      blockHandler(-1),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			honest, err := client.VerifyNodeHonesty(ctx, 5)
+			assert.False(t, honest)
+			assert.Equal(t, context.Canceled, err)
+			assert.Empty(t, node.Calls("getblock"))
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.VerifyNodeHonesty(context.Background(), 5)
+			assert.Error(t, err)
+			assert.False(t, errors.As(err, &neo.BlockError{}))
+
+			_, err = client.VerifyNodeHonesty(context.Background(), 0)
+			assert.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,126 @@
+package neo
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WaitUntilSynced blocks until the Client's node is within tolerance blocks of the
+// highest block count reported by the reference nodes, checking every interval. Reference
+// nodes which cannot be reached are ignored, at least one must respond. If ctx is done
+// before the node has caught up, the ctx error is returned wrapped with the height gap
+// found by the last check which ctx did not cut short.
+func (c Client) WaitUntilSynced(ctx context.Context, otherNodes []string, tolerance int64, interval time.Duration) error {
+	c = c.WithContext(ctx)
+
+	var (
+		gap     int64
+		err     error
+		checked bool
+	)
+
+	for {
+		nextGap, nextErr := c.heightGap(otherNodes)
+		if ctx.Err() == nil {
+			gap, err, checked = nextGap, nextErr, true
+
+			if err == nil && gap <= tolerance {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if !checked {
+				return ctx.Err()
+			}
+
+			if err != nil {
+				return errors.Wrap(ctx.Err(), err.Error())
+			}
+
+			return errors.Wrapf(ctx.Err(), "node is %d blocks behind the reference nodes", gap)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// heightGap returns how many blocks the Client's node is behind the highest of the
+// reference nodes.
+func (c Client) heightGap(otherNodes []string) (int64, error) {
+	height, err := c.GetBlockCount()
+	if err != nil {
+		return 0, err
+	}
+
+	highestBlock := int64(-1)
+
+	blockCounts, _ := c.probeBlockCounts(otherNodes)
+
+	for _, blockCount := range blockCounts {
+		if blockCount > highestBlock {
+			highestBlock = blockCount
+		}
+	}
+
+	if highestBlock < 0 {
+		return 0, fmt.Errorf("Unable to communicate with any reference nodes")
+	}
+
+	return highestBlock - height, nil
+}
+
+// SafeReadHeight returns the highest block index which every one of the Client's nodes
+// has, so that index based reads at or below it succeed whichever node serves them. Every
+// node must respond, as a node which cannot be reached may be behind the others. When
+// any node fails a BatchError keyed by node URI is returned.
+//
+// Every node is probed on each call, concurrently, so it takes as long as the slowest
+// node to respond. Callers making many reads should call it once and reuse the height.
+func (c Client) SafeReadHeight() (int64, error) {
+	blockCounts, err := c.probeBlockCounts(c.nodeURIs)
+	if err != nil {
+		return 0, err
+	}
+
+	lowestBlock := int64(-1)
+	for _, blockCount := range blockCounts {
+		if lowestBlock < 0 || blockCount < lowestBlock {
+			lowestBlock = blockCount
+		}
+	}
+
+	return lowestBlock - 1, nil
+}
+
+// probeBlockCounts fetches the block count of each of the nodes concurrently, and returns
+// them by node URI. Nodes which cannot be reached are left out, and returned in a
+// BatchError along with the block counts of the others.
+func (c Client) probeBlockCounts(nodeURIs []string) (map[string]int64, error) {
+	var mutex sync.Mutex
+	blockCounts := make(map[string]int64, len(nodeURIs))
+
+	batchErr := runConcurrently(c.context(), nodeURIs, len(nodeURIs), func(nodeURI string) error {
+		tempClient := c.forNode(nodeURI)
+
+		blockCount, err := tempClient.GetBlockCount()
+		if err != nil {
+			return err
+		}
+
+		mutex.Lock()
+		blockCounts[nodeURI] = blockCount
+		mutex.Unlock()
+
+		return nil
+	})
+	if batchErr != nil {
+		return blockCounts, batchErr
+	}
+
+	return blockCounts, c.context().Err()
+}
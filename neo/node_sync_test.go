@@ -0,0 +1,138 @@
+package neo_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeSync(t *testing.T) {
+	t.Run(".WaitUntilSynced()", func(t *testing.T) {
+		reference := newTestNode(map[string]testHandler{
+			"getblockcount": testResult(105),
+		})
+		defer reference.Close()
+
+		offline := newTestNode(map[string]testHandler{})
+		defer offline.Close()
+
+		otherNodes := []string{offline.URL, reference.URL}
+
+		t.Run("WithinTolerance", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblockcount": testResult(100),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			err := client.WaitUntilSynced(context.Background(), otherNodes, 5, time.Millisecond)
+			assert.NoError(t, err)
+		})
+
+		t.Run("CatchesUp", func(t *testing.T) {
+			height := 100
+			node := newTestNode(map[string]testHandler{
+				"getblockcount": func([]json.RawMessage) (interface{}, *testRPCError) {
+					height += 2
+					return height, nil
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			err := client.WaitUntilSynced(context.Background(), otherNodes, 0, time.Millisecond)
+			assert.NoError(t, err)
+			assert.Len(t, node.Calls("getblockcount"), 3)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblockcount": testResult(90),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			err := client.WaitUntilSynced(ctx, otherNodes, 5, time.Millisecond)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "15 blocks behind")
+			assert.Equal(t, context.DeadlineExceeded, errors.Cause(err))
+		})
+
+		t.Run("HangingNode", func(t *testing.T) {
+			node := newHangingNode()
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			err := client.WaitUntilSynced(ctx, otherNodes, 5, time.Millisecond)
+			assert.Equal(t, context.DeadlineExceeded, errors.Cause(err))
+		})
+	})
+
+	t.Run(".SafeReadHeight()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			var nodeURIs []string
+			for _, blockCount := range []int{105, 98, 101} {
+				node := newTestNode(map[string]testHandler{
+					"getblockcount": testResult(blockCount),
+				})
+				defer node.Close()
+
+				nodeURIs = append(nodeURIs, node.URL)
+			}
+
+			client, err := neo.NewClientUsingMultipleNodes(nodeURIs)
+			assert.NoError(t, err)
+
+			height, err := client.SafeReadHeight()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(97), height)
+		})
+
+		t.Run("OfflineNode", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblockcount": testResult(105),
+			})
+			defer node.Close()
+
+			offline := newTestNode(map[string]testHandler{})
+			defer offline.Close()
+
+			client, err := neo.NewClientUsingMultipleNodes([]string{offline.URL, node.URL})
+			assert.NoError(t, err)
+
+			_, err = client.SafeReadHeight()
+			assert.Error(t, err)
+
+			batchErr, ok := err.(neo.BatchError)
+			assert.True(t, ok)
+			assert.Len(t, batchErr, 1)
+			assert.Contains(t, batchErr, offline.URL)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			offline := newTestNode(map[string]testHandler{})
+			defer offline.Close()
+
+			client, err := neo.NewClientUsingMultipleNodes([]string{offline.URL})
+			assert.NoError(t, err)
+
+			_, err = client.SafeReadHeight()
+			assert.Error(t, err)
+		})
+	})
+}
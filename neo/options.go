@@ -0,0 +1,256 @@
+package neo
+
+import (
+	"net/http"
+	"time"
+)
+
+type (
+	// Option configures optional behaviour of a Client. Options are passed to NewClient
+	// or NewClientUsingMultipleNodes.
+	Option func(*Client)
+
+	// SendOption sets one of the optional parameters of SendToAddress and SendFrom.
+	SendOption func(*sendOptions)
+
+	sendOptions struct {
+		fee           interface{}
+		changeAddress string
+	}
+)
+
+// WithTransactionCache enables caching of confirmed transactions returned by
+// GetTransaction, holding at most maxEntries transactions. When the cache is full the
+// oldest transaction is evicted.
+func WithTransactionCache(maxEntries int) Option {
+	return func(c *Client) {
+		c.transactionCache = newTransactionCache(maxEntries)
+	}
+}
+
+// WithRetries enables retrying of failed requests, each request is retried up to
+// maxRetries times waiting delay between attempts. Which failures are retried is decided
+// by DefaultRetryClassifier, unless WithRetryClassifier is used. JSON-RPC errors returned
+// by the node are never retried, and nor are calls which could take effect twice, such as
+// SendToAddress, SendRawTransaction or SubmitBlock.
+func WithRetries(maxRetries int, delay time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.retryDelay = delay
+	}
+}
+
+// WithRetryClassifier sets the function which decides whether a failed request is
+// retried, it is only consulted when retries are enabled with WithRetries.
+func WithRetryClassifier(fn func(err error, resp *http.Response) bool) Option {
+	return func(c *Client) {
+		c.retryClassifier = fn
+	}
+}
+
+// WithCircuitBreaker enables a circuit breaker for each node. After failureThreshold
+// consecutive failed requests (connection errors or non-200 status codes) the node's
+// breaker opens and the node is skipped for the cooldown period, after which a single
+// trial request decides whether it is closed again. While enabled, a request which fails
+// on the active node is failed over to the Client's other nodes, in order, except for
+// wallet methods (see WithWalletMethodCheck), which act on the active node's wallet.
+func WithCircuitBreaker(failureThreshold int, cooldown time.Duration) Option {
+	return func(c *Client) {
+		c.circuitBreakers = newCircuitBreakers(failureThreshold, cooldown)
+	}
+}
+
+// WithWalletMethodCheck makes wallet methods (DumpPrivKey, GetBalance, GetNewAddress,
+// GetWalletHeight, ImportPrivKey, ListAddress, SendFrom, SendToAddress, SendMany) check,
+// once, that the node exposes wallet methods before calling them. If it does not they return
+// ErrWalletMethodsUnavailable instead of the node's "method not found" error. The check
+// costs an extra call, so it is off by default.
+func WithWalletMethodCheck() Option {
+	return func(c *Client) {
+		c.walletCapability = &walletCapability{}
+	}
+}
+
+// WithSelectionTolerance makes SelectBestNode treat nodes which are at most blocks behind
+// the highest block count as tied with the highest node, the first of them in the node
+// URIs is then selected. This keeps selection stable when nodes are only a block or two
+// apart.
+func WithSelectionTolerance(blocks int64) Option {
+	return func(c *Client) {
+		c.selectionTolerance = blocks
+	}
+}
+
+// OnNodeChange sets a function which is called whenever the node serving requests
+// changes, with the URIs of the old and new node. Changes are caused by SelectBestNode
+// picking a different node, and, with WithCircuitBreaker, by a request failing over to
+// another node (and back again once the active node recovers). The initial selection of
+// a node does not call fn. fn is called synchronously, without holding any lock of the
+// Client, so it should return quickly but may use the Client. A nil fn is ignored.
+func OnNodeChange(fn func(old, new string)) Option {
+	return func(c *Client) {
+		if fn == nil {
+			return
+		}
+
+		c.nodeChange = &nodeChangeNotifier{fn: fn}
+	}
+}
+
+// WithNetworkGeneration sets the network generation (NEO2 or NEO3) of the nodes, which
+// decides how addresses are encoded and which token methods are supported. Without it the
+// generation of each node is detected from its getversion response the first time it is
+// needed, see Negotiate.
+func WithNetworkGeneration(generation NetworkGeneration) Option {
+	return func(c *Client) {
+		c.network = &networkDetection{generation: generation}
+	}
+}
+
+// WithNNSContract sets the script hash of the Neo Name Service contract used by
+// ResolveName, for networks other than the NEO3 MainNet.
+func WithNNSContract(scriptHash string) Option {
+	return func(c *Client) {
+		c.nnsContract = scriptHash
+	}
+}
+
+// WithPolicyContract sets the script hash of the policy contract used by GetPolicy, for
+// networks where it differs from DefaultPolicyContract.
+func WithPolicyContract(scriptHash string) Option {
+	return func(c *Client) {
+		c.policyContract = scriptHash
+	}
+}
+
+// WithAddressVersion sets the version byte of addresses on the node's network, for
+// private networks which do not use the standard version of their network generation.
+func WithAddressVersion(version byte) Option {
+	return func(c *Client) {
+		c.addressVersion = version
+	}
+}
+
+// WithCertPinning pins the TLS certificates of the nodes. Each pin is the SHA-256 of the
+// DER encoded SubjectPublicKeyInfo of a certificate, as 32 raw bytes, which for a PEM
+// certificate is output by:
+//
+//	openssl x509 -in node.pem -pubkey -noout | openssl pkey -pubin -outform der | openssl dgst -sha256 -binary
+//
+// The public key of the certificate presented by the node must match one of the pins, or
+// the connection is rejected. The pin takes the place of verification against the
+// system's certificate authorities, so it defends against a compromised authority and
+// allows self-signed node certificates to be used.
+//
+// When used with WithHTTPClient, the pins are added to a copy of its HTTP client, whatever
+// the order the options are passed in. Its transport must then be an *http.Transport, or
+// nil, otherwise every request fails with ErrPinningUnsupportedTransport.
+func WithCertPinning(pins [][]byte) Option {
+	return func(c *Client) {
+		c.certificatePins = pins
+		c.certPinning = true
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send requests to the nodes, such as one
+// with a timeout, a proxy or a custom TLS configuration. Without it, all Clients share a
+// default HTTP client so that connections to nodes are reused. The HTTP client should be
+// shared between Clients too, rather than created for each one.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+		c.customHTTPClient = true
+	}
+}
+
+// WithTimeout sets how long each call to a node may take, including any retries, before
+// it is abandoned, for methods without a timeout set with WithMethodTimeout. The error
+// returned when the timeout passes wraps context.DeadlineExceeded. With
+// WithCircuitBreaker, a node which times out counts as a failure and the call is failed
+// over to the next node, which is allowed the full timeout again. The default, 0, means
+// there is no timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithMethodTimeout sets how long each call of the JSON-RPC method, such as "getblock",
+// may take, in place of the timeout set with WithTimeout. This allows strict timeouts for
+// cheap methods while allowing expensive ones more time. A timeout of 0 means calls of the
+// method have no timeout. A JSON-RPC batch holding several methods uses the longest of
+// their timeouts.
+func WithMethodTimeout(method string, timeout time.Duration) Option {
+	return func(c *Client) {
+		methodTimeouts := make(map[string]time.Duration, len(c.methodTimeouts)+1)
+		for existingMethod, existingTimeout := range c.methodTimeouts {
+			methodTimeouts[existingMethod] = existingTimeout
+		}
+		methodTimeouts[method] = timeout
+
+		c.methodTimeouts = methodTimeouts
+	}
+}
+
+// WithResponseHeaders makes the Client capture the HTTP headers of each response from the
+// nodes, the last of which are returned by LastResponseHeaders. It is off by default, as
+// the headers are copied on every request.
+func WithResponseHeaders() Option {
+	return func(c *Client) {
+		c.responseHeaders = &responseHeaders{}
+	}
+}
+
+// WithMaxBatchSize sets the maximum number of calls sent in a single JSON-RPC batch by the
+// batch methods, such as GetAccountStates. Larger sets of calls are split into several
+// batches, so that no request exceeds the body size limit of the node. The default is
+// DefaultMaxBatchSize.
+func WithMaxBatchSize(size int) Option {
+	return func(c *Client) {
+		c.maxBatchSize = size
+	}
+}
+
+// WithFee sets the network fee paid by the transaction sent by SendToAddress or SendFrom.
+func WithFee(fee interface{}) SendOption {
+	return func(s *sendOptions) {
+		s.fee = fee
+	}
+}
+
+// WithChangeAddress sets the address which the change of the transaction sent by
+// SendToAddress or SendFrom is returned to.
+func WithChangeAddress(address string) SendOption {
+	return func(s *sendOptions) {
+		s.changeAddress = address
+	}
+}
+
+func newSendOptions(options []SendOption) sendOptions {
+	var s sendOptions
+	for _, option := range options {
+		option(&s)
+	}
+
+	return s
+}
+
+// parameters returns the optional request parameters, which follow the required ones.
+// The node reads them by position, so a fee of 0 is sent when only the change address
+// is set.
+func (s sendOptions) parameters() []interface{} {
+	if s.changeAddress != "" {
+		fee := s.fee
+		if fee == nil {
+			fee = 0
+		}
+
+		return []interface{}{fee, s.changeAddress}
+	}
+
+	if s.fee != nil {
+		return []interface{}{s.fee}
+	}
+
+	return nil
+}
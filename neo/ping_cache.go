@@ -0,0 +1,64 @@
+package neo
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	// pingCache holds the outcome of the last Ping of each node URI. It is shared by copies
+	// of the Client which created it, so all access is guarded by the mutex.
+	pingCache struct {
+		mutex   sync.Mutex
+		results map[string]pingResult
+	}
+
+	pingResult struct {
+		ok       bool
+		pingedAt time.Time
+	}
+)
+
+// PingCached is like Ping, but returns the outcome of the last Ping of the node when it is
+// no older than maxAge, rather than opening a new connection. It is intended for health
+// checks which run in a tight loop. Ping always opens a new connection, and its outcome
+// is also used by PingCached.
+func (c Client) PingCached(maxAge time.Duration) bool {
+	if ok, found := c.pings.get(c.Node, maxAge); found {
+		return ok
+	}
+
+	return c.Ping()
+}
+
+// get returns the outcome of the last Ping of the node, if it is no older than maxAge.
+func (p *pingCache) get(nodeURI string, maxAge time.Duration) (bool, bool) {
+	if p == nil {
+		return false, false
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	result, ok := p.results[nodeURI]
+	if !ok || time.Since(result.pingedAt) > maxAge {
+		return false, false
+	}
+
+	return result.ok, true
+}
+
+func (p *pingCache) set(nodeURI string, ok bool) {
+	if p == nil {
+		return
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.results == nil {
+		p.results = map[string]pingResult{}
+	}
+
+	p.results[nodeURI] = pingResult{ok: ok, pingedAt: time.Now()}
+}
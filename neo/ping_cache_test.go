@@ -0,0 +1,65 @@
+package neo_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPingCache(t *testing.T) {
+	t.Run(".PingCached()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			client := neo.NewClient(node.URL)
+
+			assert.True(t, client.PingCached(time.Minute))
+
+			node.Close()
+
+			assert.True(t, client.PingCached(time.Minute))
+			assert.False(t, client.PingCached(0))
+		})
+
+		t.Run("PerNode", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+			assert.True(t, client.PingCached(time.Minute))
+
+			client.Node = "/foo"
+			assert.False(t, client.PingCached(time.Minute))
+		})
+
+		t.Run("Concurrent", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					assert.True(t, client.PingCached(time.Millisecond))
+				}()
+			}
+
+			wg.Wait()
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			for _, testPing := range testPings {
+				t.Run(testPing.description, func(t *testing.T) {
+					client := neo.NewClient(testPing.uri)
+
+					assert.False(t, client.PingCached(time.Minute))
+				})
+			}
+		})
+	})
+}
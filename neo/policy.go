@@ -0,0 +1,63 @@
+package neo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+// DefaultPolicyContract is the script hash of the native PolicyContract on NEO3 networks,
+// used by GetPolicy unless WithPolicyContract is used.
+const DefaultPolicyContract = "0xcc5e4edd9f5f8dba8bb65734541df7a1c081c67b"
+
+// GetPolicy returns the fee settings of the network, by test invoking the getters of the
+// policy contract, which is DefaultPolicyContract unless the Client was created with
+// WithPolicyContract. ErrUnsupportedNetworkGeneration is returned for NEO2 nodes.
+func (c Client) GetPolicy() (*models.Policy, error) {
+	if err := c.checkNetworkGeneration(NEO3); err != nil {
+		return nil, err
+	}
+
+	contract := c.policyContract
+	if contract == "" {
+		contract = DefaultPolicyContract
+	}
+
+	var policy models.Policy
+
+	getters := []struct {
+		operation string
+		value     *int64
+	}{
+		{"getFeePerByte", &policy.FeePerByte},
+		{"getExecFeeFactor", &policy.ExecFeeFactor},
+		{"getStoragePrice", &policy.StoragePrice},
+	}
+
+	for _, getter := range getters {
+		operation := getter.operation
+
+		result, err := c.InvokeFunction(contract, operation, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.Contains(result.State, "FAULT") || len(result.Stack) == 0 {
+			return nil, fmt.Errorf("policy contract %s failed: %s", operation, result.Exception)
+		}
+
+		integer, err := result.Stack[0].AsInteger()
+		if err != nil {
+			return nil, err
+		}
+
+		if !integer.IsInt64() {
+			return nil, fmt.Errorf("policy contract %s returned an out of range value: %s", operation, integer)
+		}
+
+		*getter.value = integer.Int64()
+	}
+
+	return &policy, nil
+}
@@ -0,0 +1,106 @@
+package neo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicy(t *testing.T) {
+	neo3Version := testRawResult(`{"tcpport": 10333, "useragent": "/Neo:3.0.3/"}`)
+
+	policyHandler := func(params []json.RawMessage) (interface{}, *testRPCError) {
+		var operation string
+		_ = json.Unmarshal(params[1], &operation)
+
+		values := map[string]string{
+			"getFeePerByte":    "1000",
+			"getExecFeeFactor": "30",
+			"getStoragePrice":  "100000",
+		}
+
+		return json.RawMessage(`{
+			"state": "HALT",
+			"gasconsumed": "984060",
+			"exception": null,
+			"stack": [{"type": "Integer", "value": "` + values[operation] + `"}]
+		}`), nil
+	}
+
+	t.Run(".GetPolicy()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":     neo3Version,
+				"invokefunction": policyHandler,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			policy, err := client.GetPolicy()
+			assert.NoError(t, err)
+			assert.Equal(t, &models.Policy{
+				FeePerByte:    1000,
+				ExecFeeFactor: 30,
+				StoragePrice:  100000,
+			}, policy)
+
+			calls := node.Calls("invokefunction")
+			assert.Len(t, calls, 3)
+			for _, call := range calls {
+				assert.JSONEq(t, `"`+neo.DefaultPolicyContract+`"`, string(call.Params[0]))
+			}
+		})
+
+		t.Run("WithPolicyContract", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":     neo3Version,
+				"invokefunction": policyHandler,
+			})
+			defer node.Close()
+
+			contract := "0x79bcd398505eb779df6e67e4be6c14cded08e2f2"
+			client := neo.NewClient(node.URL, neo.WithPolicyContract(contract))
+
+			_, err := client.GetPolicy()
+			assert.NoError(t, err)
+			assert.JSONEq(t, `"`+contract+`"`, string(node.Calls("invokefunction")[0].Params[0]))
+			assert.Equal(t, contract, client.Config().PolicyContract)
+		})
+
+		t.Run("NEO2Node", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":     testRawResult(`{"port": 10333, "useragent": "/NEO:2.10.3/"}`),
+				"invokefunction": policyHandler,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetPolicy()
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, err)
+			assert.Empty(t, node.Calls("invokefunction"))
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": neo3Version,
+				"invokefunction": testRawResult(`{
+					"state": "FAULT",
+					"exception": "Called Contract Does Not Exist",
+					"stack": []
+				}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetPolicy()
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "Called Contract Does Not Exist")
+		})
+	})
+}
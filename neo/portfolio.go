@@ -0,0 +1,146 @@
+package neo
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+// assetSymbols holds the symbols of the native NEO2 assets, whose asset states only have
+// localized names.
+var assetSymbols = map[string]string{
+	NEOAssetID: "NEO",
+	GASAssetID: "GAS",
+}
+
+type (
+	// assetCache holds the asset states fetched by GetPortfolio, keyed by asset ID. The
+	// names and precision of an asset never change, so entries are kept for the lifetime
+	// of the Client. It is shared by copies of the Client which created it, so all access
+	// is guarded by the mutex.
+	assetCache struct {
+		mutex  sync.Mutex
+		assets map[string]models.AssetState
+	}
+)
+
+// GetPortfolio returns the global asset balances of the address, in the order of its
+// account state, each with the symbol, English name and precision of the asset ready for
+// display. The asset states are fetched with getassetstate the first time each asset is
+// seen, and cached for the lifetime of the Client. NEO and GAS have the symbols "NEO" and
+// "GAS", other assets, which have no symbol, use their name. Global assets only exist on
+// NEO2, so this is only supported by NEO2 nodes.
+func (c Client) GetPortfolio(address string) ([]models.AssetBalance, error) {
+	if err := c.checkNetworkGeneration(NEO2); err != nil {
+		return nil, err
+	}
+
+	accountState, err := c.GetAccountState(address)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio := make([]models.AssetBalance, 0, len(accountState.Balances))
+
+	for _, balance := range accountState.Balances {
+		assetID, err := NormalizeAssetID(balance.Asset)
+		if err != nil {
+			return nil, err
+		}
+
+		asset, err := c.getCachedAssetState(assetID)
+		if err != nil {
+			return nil, err
+		}
+
+		name := asset.LocalizedName("en")
+
+		symbol, ok := assetSymbols[assetID]
+		if !ok {
+			symbol = name
+		}
+
+		portfolio = append(portfolio, models.AssetBalance{
+			Asset:        balance.Asset,
+			Symbol:       symbol,
+			Name:         name,
+			Precision:    asset.Precision,
+			Value:        balance.Value,
+			DisplayValue: formatFixed8(balance.Value, asset.Precision),
+		})
+	}
+
+	return portfolio, nil
+}
+
+// getCachedAssetState returns the state of the asset, whose ID has been normalized by
+// NormalizeAssetID, from the Client's asset cache, fetching it when it is not cached.
+func (c Client) getCachedAssetState(assetID string) (*models.AssetState, error) {
+	if asset, ok := c.assets.get(assetID); ok {
+		return asset, nil
+	}
+
+	asset, err := c.GetAssetState(assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	c.assets.add(assetID, *asset)
+	return asset, nil
+}
+
+// get returns a copy of the cached state of the asset, if there is one.
+func (a *assetCache) get(assetID string) (*models.AssetState, bool) {
+	if a == nil {
+		return nil, false
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	asset, ok := a.assets[assetID]
+	if !ok {
+		return nil, false
+	}
+
+	return &asset, true
+}
+
+func (a *assetCache) add(assetID string, asset models.AssetState) {
+	if a == nil {
+		return
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.assets == nil {
+		a.assets = map[string]models.AssetState{}
+	}
+
+	a.assets[assetID] = asset
+}
+
+// formatFixed8 returns the value as a decimal string with precision decimal places. Digits
+// beyond the precision, which the node does not allow, are dropped.
+func formatFixed8(value models.Fixed8, precision int) string {
+	if precision < 0 || precision > 8 {
+		precision = 8
+	}
+
+	sign := ""
+	amount := uint64(value)
+	if value < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	whole := amount / models.Fixed8Decimals
+	if precision == 0 {
+		return fmt.Sprintf("%s%d", sign, whole)
+	}
+
+	fraction := fmt.Sprintf("%08d", amount%models.Fixed8Decimals)
+	return fmt.Sprintf("%s%d.%s", sign, whole, fraction[:precision])
+}
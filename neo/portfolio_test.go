@@ -0,0 +1,129 @@
+package neo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortfolio(t *testing.T) {
+	assetStates := map[string]models.AssetState{
+		"0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b": {
+			Name:      []models.AssetName{{Lang: "zh-CN", Name: "小蚁股"}, {Lang: "en", Name: "AntShare"}},
+			Precision: 0,
+		},
+		"0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7": {
+			Name:      []models.AssetName{{Lang: "zh-CN", Name: "小蚁币"}, {Lang: "en", Name: "AntCoin"}},
+			Precision: 8,
+		},
+		"0x025d82f7b00a9ff1cfe709abe3c4741a105d067178e645bc3ebad9bc79af47d4": {
+			Name:      []models.AssetName{{Lang: "en", Name: "TestCoin"}},
+			Precision: 2,
+		},
+	}
+
+	handlers := func() map[string]testHandler {
+		return map[string]testHandler{
+			"getaccountstate": testRawResult(`{
+				"version": 0,
+				"script_hash": "0x1179716da2e9523d153a35fb3ad10c561b1e5b1a",
+				"frozen": false,
+				"votes": [],
+				"balances": [
+					{"asset": "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b", "value": "100"},
+					{"asset": "0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7", "value": "1.5"},
+					{"asset": "0x025d82f7b00a9ff1cfe709abe3c4741a105d067178e645bc3ebad9bc79af47d4", "value": "12.34"}
+				]
+			}`),
+			"getassetstate": func(params []json.RawMessage) (interface{}, *testRPCError) {
+				var assetID string
+				_ = json.Unmarshal(params[0], &assetID)
+
+				asset, ok := assetStates[assetID]
+				if !ok {
+					return nil, &testRPCError{Code: -100, Message: "Unknown asset"}
+				}
+
+				return asset, nil
+			},
+		}
+	}
+
+	t.Run(".GetPortfolio()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(handlers())
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			portfolio, err := client.GetPortfolio(testAccounts[0].publicAddress)
+			assert.NoError(t, err)
+			assert.Equal(t, []models.AssetBalance{
+				{
+					Asset:        "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b",
+					Symbol:       "NEO",
+					Name:         "AntShare",
+					Precision:    0,
+					Value:        models.NewFixed8(100),
+					DisplayValue: "100",
+				},
+				{
+					Asset:        "0x602c79718b16e442de58778e148d0b1084e3b2dffd5de6b7b16cee7969282de7",
+					Symbol:       "GAS",
+					Name:         "AntCoin",
+					Precision:    8,
+					Value:        150000000,
+					DisplayValue: "1.50000000",
+				},
+				{
+					Asset:        "0x025d82f7b00a9ff1cfe709abe3c4741a105d067178e645bc3ebad9bc79af47d4",
+					Symbol:       "TestCoin",
+					Name:         "TestCoin",
+					Precision:    2,
+					Value:        1234000000,
+					DisplayValue: "12.34",
+				},
+			}, portfolio)
+		})
+
+		t.Run("CachesAssetStates", func(t *testing.T) {
+			node := newTestNode(handlers())
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			for i := 0; i < 2; i++ {
+				_, err := client.GetPortfolio(testAccounts[0].publicAddress)
+				assert.NoError(t, err)
+			}
+
+			assert.Len(t, node.Calls("getaccountstate"), 2)
+			assert.Len(t, node.Calls("getassetstate"), 3)
+		})
+
+		t.Run("UnknownAsset", func(t *testing.T) {
+			unknownAsset := handlers()
+			unknownAsset["getassetstate"] = func([]json.RawMessage) (interface{}, *testRPCError) {
+				return nil, &testRPCError{Code: -100, Message: "Unknown asset"}
+			}
+
+			node := newTestNode(unknownAsset)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			_, err := client.GetPortfolio(testAccounts[0].publicAddress)
+			assert.Equal(t, neo.RPCError{Code: -100, Message: "Unknown asset"}, err)
+		})
+
+		t.Run("NEO3Node", func(t *testing.T) {
+			client := neo.NewClient("http://localhost:10332", neo.WithNetworkGeneration(neo.NEO3))
+
+			_, err := client.GetPortfolio(testAccounts[0].publicAddress)
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, err)
+		})
+	})
+}
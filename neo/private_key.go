@@ -10,7 +10,6 @@ import (
 	"math/big"
 
 	"github.com/lomocoin/neo-go-sdk/utility"
-	"golang.org/x/crypto/ripemd160"
 )
 
 type (
@@ -69,33 +68,27 @@ func NewPrivateKeyFromWIF(wif string) (*PrivateKey, error) {
 }
 
 // PublicAddress derives the public NEO address that is coupled with the private key, and
-// returns it as a string.
+// returns it as a string. The address is a NEO2 address, use PublicAddressForNetwork for
+// NEO3 addresses.
 func (p PrivateKey) PublicAddress() (string, error) {
-	bytes, err := p.Signature()
+	return p.PublicAddressForNetwork(NEO2)
+}
+
+// PublicAddressForNetwork derives the public address that is coupled with the private
+// key on the given network generation, and returns it as a string.
+func (p PrivateKey) PublicAddressForNetwork(generation NetworkGeneration) (string, error) {
+	return p.publicAddress(generation, generation.addressVersion())
+}
+
+func (p PrivateKey) publicAddress(generation NetworkGeneration, version byte) (string, error) {
+	publicKey, err := p.PublicKey()
 	if err != nil {
 		return "", err
 	}
 
-	var ver uint8 = 0x17
-
-	bytes = append([]byte{ver}, bytes...)
-
-	sha256H := sha256.New()
-
-	sha256H.Reset()
-	sha256H.Write(bytes)
-	hashThree := sha256H.Sum(nil)
+	scriptHash := hash160(generation.verificationScript(publicKey))
 
-	sha256H.Reset()
-	sha256H.Write(hashThree)
-	hashFour := sha256H.Sum(nil)
-
-	bytes = append(bytes, hashFour[0:4]...)
-
-	base58 := utility.NewBase58()
-	address := base58.Encode(bytes)
-
-	return address, nil
+	return encodeAddress(version, scriptHash), nil
 }
 
 // PublicKey derives the public key that is coupled with the private key, and returns it
@@ -150,20 +143,7 @@ func (p PrivateKey) Signature() ([]byte, error) {
 		return nil, err
 	}
 
-	bytes = append([]byte{0x21}, bytes...)
-	bytes = append(bytes, 0xAC)
-
-	sha256H := sha256.New()
-	sha256H.Reset()
-	sha256H.Write(bytes)
-	hashOne := sha256H.Sum(nil)
-
-	ripemd160H := ripemd160.New()
-	ripemd160H.Reset()
-	ripemd160H.Write(hashOne)
-	hashTwo := ripemd160H.Sum(nil)
-
-	return hashTwo, nil
+	return hash160(NEO2.verificationScript(bytes)), nil
 }
 
 func (p PrivateKey) createEllipticCurve() utility.EllipticCurve {
@@ -0,0 +1,160 @@
+package neo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// NEO2 transaction types, which decide the type specific data following the version.
+const (
+	minerTransaction      = 0x00
+	issueTransaction      = 0x01
+	claimTransaction      = 0x02
+	enrollmentTransaction = 0x20
+	registerTransaction   = 0x40
+	contractTransaction   = 0x80
+	stateTransaction      = 0x90
+	publishTransaction    = 0xD0
+	invocationTransaction = 0xD1
+)
+
+type (
+	// rawTransaction is a NEO2 transaction decoded from its serialized form, only as far
+	// as is needed to work out its hash.
+	rawTransaction struct {
+		Type     byte
+		Version  byte
+		unsigned []byte
+	}
+)
+
+// decodeRawTransaction decodes a hex encoded, serialized, NEO2 transaction.
+func decodeRawTransaction(rawHex string) (*rawTransaction, error) {
+	raw, err := hex.DecodeString(rawHex)
+	if err != nil {
+		return nil, fmt.Errorf("Raw transaction is not valid hex: %s", err)
+	}
+
+	r := newBinaryReader(raw)
+	transaction := &rawTransaction{
+		Type:    r.readByte(),
+		Version: r.readByte(),
+	}
+
+	if err := transaction.readExclusiveData(r); err != nil {
+		return nil, err
+	}
+
+	// attributes
+	for i, count := 0, r.readCount(1); i < count && r.err == nil; i++ {
+		readTransactionAttribute(r)
+	}
+
+	// inputs are a 32 byte transaction hash and a 2 byte index, outputs are a 32 byte
+	// asset ID, an 8 byte value and a 20 byte script hash
+	r.readBytes(r.readCount(34) * 34)
+	r.readBytes(r.readCount(60) * 60)
+
+	if r.err != nil {
+		return nil, fmt.Errorf("Unable to decode raw transaction: %s", r.err)
+	}
+
+	transaction.unsigned = raw[:r.offset]
+	return transaction, nil
+}
+
+// Hash returns the hash (transaction ID) of the transaction, which is the double SHA-256
+// of the transaction without its witnesses, as "0x" prefixed big-endian hex.
+func (t rawTransaction) Hash() string {
+	firstSHA := sha256.Sum256(t.unsigned)
+	secondSHA := sha256.Sum256(firstSHA[:])
+
+	return "0x" + hex.EncodeToString(reverseBytes(secondSHA[:]))
+}
+
+func (t *rawTransaction) readExclusiveData(r *binaryReader) error {
+	switch t.Type {
+	case minerTransaction:
+		r.readUint32()
+	case issueTransaction, contractTransaction:
+	case claimTransaction:
+		r.readBytes(r.readCount(34) * 34)
+	case enrollmentTransaction:
+		readECPoint(r)
+	case registerTransaction:
+		r.readByte()
+		r.readVarBytes()
+		r.readUint64()
+		r.readByte()
+		readECPoint(r)
+		r.readBytes(20)
+	case stateTransaction:
+		for i, count := 0, r.readCount(4); i < count && r.err == nil; i++ {
+			r.readByte()
+			r.readVarBytes()
+			r.readVarBytes()
+			r.readVarBytes()
+		}
+	case publishTransaction:
+		r.readVarBytes()
+		r.readVarBytes()
+		r.readByte()
+		if t.Version >= 1 {
+			r.readByte()
+		}
+		for i := 0; i < 5; i++ {
+			r.readVarBytes()
+		}
+	case invocationTransaction:
+		r.readVarBytes()
+		if t.Version >= 1 {
+			r.readUint64()
+		}
+	default:
+		return fmt.Errorf("Unknown transaction type: 0x%02x", t.Type)
+	}
+
+	return nil
+}
+
+// readTransactionAttribute reads an attribute, whose length depends on its usage.
+func readTransactionAttribute(r *binaryReader) {
+	switch usage := r.readByte(); {
+	case usage == 0x00 || usage == 0x30 || (usage >= 0xA1 && usage <= 0xAF):
+		// ContractHash, Vote, Hash1 to Hash15
+		r.readBytes(32)
+	case usage == 0x02 || usage == 0x03:
+		// ECDH02 and ECDH03, the usage is the first byte of the public key
+		r.readBytes(32)
+	case usage == 0x20:
+		// Script
+		r.readBytes(20)
+	case usage == 0x81:
+		// DescriptionUrl
+		r.readBytes(int(r.readByte()))
+	case usage == 0x90 || usage >= 0xF0:
+		// Description, Remark and Remark1 to Remark15
+		r.readVarBytes()
+	default:
+		if r.err == nil {
+			r.err = fmt.Errorf("invalid transaction attribute usage: 0x%02x", usage)
+		}
+	}
+}
+
+// readECPoint reads an encoded public key, which is a single 0x00 byte for the point at
+// infinity, otherwise a compressed (33 byte) or uncompressed (65 byte) point.
+func readECPoint(r *binaryReader) {
+	switch prefix := r.readByte(); prefix {
+	case 0x00:
+	case 0x02, 0x03:
+		r.readBytes(32)
+	case 0x04, 0x06, 0x07:
+		r.readBytes(64)
+	default:
+		if r.err == nil {
+			r.err = fmt.Errorf("invalid public key prefix: 0x%02x", prefix)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package neo
+
+import (
+	"context"
+	"errors"
+)
+
+// rpcErrorCodeAlreadyExists is the JSON-RPC error code returned when a node already has
+// the transaction which is sent to it.
+const rpcErrorCodeAlreadyExists = -501
+
+// ErrTransactionConfirmed is returned by RebroadcastTransaction when the transaction is
+// already in a block, so there is nothing to rebroadcast.
+var ErrTransactionConfirmed = errors.New("transaction is already confirmed")
+
+// RebroadcastTransaction fetches a transaction which is waiting in the node's mempool and
+// sends it to the node again, to nudge its propagation when it is not being confirmed. A
+// node which reports that it already has the transaction is not treated as an error.
+// ErrTransactionConfirmed is returned when the transaction is already in a block.
+func (c Client) RebroadcastTransaction(txHash string) error {
+	rawTransaction, err := c.getPendingRawTransaction(txHash)
+	if err != nil {
+		return err
+	}
+
+	return c.rebroadcast(rawTransaction)
+}
+
+// RebroadcastTransactionToAllNodes is like RebroadcastTransaction, but sends the
+// transaction to each of the Client's nodes concurrently, so that it reaches nodes which
+// the active node has not relayed it to. If any of the nodes fail a BatchError is
+// returned, keyed by node URI. When ctx is done no further nodes are sent the
+// transaction, those in flight are aborted, and ctx.Err() is returned.
+func (c Client) RebroadcastTransactionToAllNodes(ctx context.Context, txHash string) error {
+	c = c.WithContext(ctx)
+
+	rawTransaction, err := c.getPendingRawTransaction(txHash)
+	if err != nil {
+		return err
+	}
+
+	batchErr := runConcurrently(ctx, c.nodeURIs, len(c.nodeURIs), func(nodeURI string) error {
+		return c.forNode(nodeURI).rebroadcast(rawTransaction)
+	})
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if batchErr != nil {
+		return batchErr
+	}
+
+	return nil
+}
+
+// getPendingRawTransaction returns the serialized, hex encoded, transaction, or
+// ErrTransactionConfirmed when it is already in a block.
+func (c Client) getPendingRawTransaction(txHash string) (string, error) {
+	transaction, err := c.GetTransaction(txHash)
+	if err != nil {
+		return "", err
+	}
+
+	if transaction.Confirmations > 0 {
+		return "", ErrTransactionConfirmed
+	}
+
+	return c.GetRawTransactionHex(txHash)
+}
+
+func (c Client) rebroadcast(rawTransaction string) error {
+	_, err := c.sendRawTransaction(rawTransaction)
+	if rpcErr, ok := err.(RPCError); ok && rpcErr.Code == rpcErrorCodeAlreadyExists {
+		return nil
+	}
+
+	return err
+}
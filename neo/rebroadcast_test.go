@@ -0,0 +1,143 @@
+package neo_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRebroadcast(t *testing.T) {
+	txHash := "0xfb5bd72b2d6792d75dc2f1084ffa9e9f70ca85543c717a6b13d9959b452a57d6"
+	rawTransaction := "00001dac2b7c000000"
+
+	rawTransactionHandler := func(confirmations int) testHandler {
+		return func(params []json.RawMessage) (interface{}, *testRPCError) {
+			if string(params[1]) == "0" {
+				return rawTransaction, nil
+			}
+
+			return map[string]interface{}{
+				"txid":          txHash,
+				"confirmations": confirmations,
+			}, nil
+		}
+	}
+
+	t.Run(".RebroadcastTransaction()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getrawtransaction":  rawTransactionHandler(0),
+				"sendrawtransaction": testResult(true),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			err := client.RebroadcastTransaction(txHash)
+			assert.NoError(t, err)
+
+			calls := node.Calls("sendrawtransaction")
+			assert.Len(t, calls, 1)
+			assert.Equal(t, `"`+rawTransaction+`"`, string(calls[0].Params[0]))
+		})
+
+		t.Run("AlreadyExists", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getrawtransaction": rawTransactionHandler(0),
+				"sendrawtransaction": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -501, Message: "AlreadyExists"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			err := client.RebroadcastTransaction(txHash)
+			assert.NoError(t, err)
+		})
+
+		t.Run("Confirmed", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getrawtransaction":  rawTransactionHandler(3),
+				"sendrawtransaction": testResult(true),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			err := client.RebroadcastTransaction(txHash)
+			assert.Equal(t, neo.ErrTransactionConfirmed, err)
+			assert.Empty(t, node.Calls("sendrawtransaction"))
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getrawtransaction": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -100, Message: "Unknown transaction"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			err := client.RebroadcastTransaction(txHash)
+			assert.Equal(t, neo.RPCError{Code: -100, Message: "Unknown transaction"}, err)
+		})
+	})
+
+	t.Run(".RebroadcastTransactionToAllNodes()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			var nodeURIs []string
+			var nodes []*testNode
+			for i := 0; i < 3; i++ {
+				node := newTestNode(map[string]testHandler{
+					"getblockcount":      testResult(100),
+					"getrawtransaction":  rawTransactionHandler(0),
+					"sendrawtransaction": testResult(true),
+				})
+				defer node.Close()
+
+				nodes = append(nodes, node)
+				nodeURIs = append(nodeURIs, node.URL)
+			}
+
+			client, err := neo.NewClientUsingMultipleNodes(nodeURIs)
+			assert.NoError(t, err)
+
+			err = client.RebroadcastTransactionToAllNodes(context.Background(), txHash)
+			assert.NoError(t, err)
+
+			for _, node := range nodes {
+				assert.Len(t, node.Calls("sendrawtransaction"), 1)
+			}
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblockcount":      testResult(100),
+				"getrawtransaction":  rawTransactionHandler(0),
+				"sendrawtransaction": testResult(true),
+			})
+			defer node.Close()
+
+			rejecting := newTestNode(map[string]testHandler{
+				"getblockcount":      testResult(90),
+				"sendrawtransaction": testResult(false),
+			})
+			defer rejecting.Close()
+
+			client, err := neo.NewClientUsingMultipleNodes([]string{node.URL, rejecting.URL})
+			assert.NoError(t, err)
+
+			err = client.RebroadcastTransactionToAllNodes(context.Background(), txHash)
+
+			batchErr, ok := err.(neo.BatchError)
+			assert.True(t, ok)
+			assert.Len(t, batchErr, 1)
+			assert.Equal(t, neo.ErrTransactionRejected, batchErr[rejecting.URL])
+		})
+	})
+}
@@ -2,42 +2,185 @@ package neo
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/lomocoin/neo-go-sdk/neo/models/request"
 	resp "github.com/lomocoin/neo-go-sdk/neo/models/response"
 	"github.com/pkg/errors"
 )
 
-func executeRequest(method string, bodyParameters []interface{}, nodeURI string, model interface{}) error {
-	var body []byte
-	var err error
+type (
+	// RPCError is returned when the NEO node responds with a JSON-RPC error object.
+	RPCError struct {
+		Code    int
+		Message string
+	}
 
-	if bodyParameters == nil {
-		body, err = request.NewBody(method)
-		if err != nil {
-			return err
+	// RetryClassifier decides whether a failed request should be retried. err is the
+	// error returned when sending the request, and resp is the HTTP response when one was
+	// received, only one of them is set.
+	RetryClassifier func(err error, resp *http.Response) bool
+
+	// timeoutBody is the body of a response to a request with a timeout, it reports a
+	// read which is cut short by the timeout as such, and releases the timeout once closed.
+	timeoutBody struct {
+		io.ReadCloser
+		client Client
+		ctx    context.Context
+		parent context.Context
+		cancel context.CancelFunc
+	}
+)
+
+// ErrAllNodesUnavailable is returned when the circuit breaker of every node is open, so
+// there is no node to send the request to.
+var ErrAllNodesUnavailable = errors.New("circuit breaker is open for all nodes")
+
+// maxIdleConnsPerNode is the number of idle connections kept open to each node, which is
+// raised from the default of 2 so that concurrent batch calls reuse their connections.
+const maxIdleConnsPerNode = 16
+
+// maxPooledBufferSize is the capacity above which a buffer is not returned to the pool, so
+// that a single large response (such as a full block) does not pin memory.
+const maxPooledBufferSize = 1 << 20
+
+var (
+	// defaultHTTPClient is shared by all Clients without their own HTTP client, so that
+	// connections to nodes are pooled per node host and reused, including by copies of a
+	// Client made to switch or fail over between nodes.
+	defaultHTTPClient = &http.Client{Transport: newDefaultTransport()}
+
+	// bufferPool holds the buffers used to encode request bodies and read responses.
+	bufferPool = sync.Pool{
+		New: func() interface{} {
+			return new(bytes.Buffer)
+		},
+	}
+
+	errorKey = []byte(`"error"`)
+
+	// nonIdempotentMethods are the methods which can have taken effect on the node even
+	// though their request failed, such as by timing out, so sending them again could
+	// spend twice. They are never retried.
+	nonIdempotentMethods = map[string]bool{
+		"getnewaddress":      true,
+		"importprivkey":      true,
+		"sendfrom":           true,
+		"sendmany":           true,
+		"sendrawtransaction": true,
+		"sendtoaddress":      true,
+		"submitblock":        true,
+	}
+
+	// walletMethods are the methods which act on the wallet of the node they are sent to,
+	// so they are never failed over to another node.
+	walletMethods = map[string]bool{
+		"dumpprivkey":     true,
+		"getbalance":      true,
+		"getnewaddress":   true,
+		"getwalletheight": true,
+		"importprivkey":   true,
+		"listaddress":     true,
+		"sendfrom":        true,
+		"sendmany":        true,
+		"sendtoaddress":   true,
+	}
+)
+
+// Error implements the error interface.
+func (e RPCError) Error() string {
+	return fmt.Sprintf("error code: %v, error message: %v", e.Code, e.Message)
+}
+
+// DefaultRetryClassifier is the RetryClassifier used when retries are enabled with
+// WithRetries. Connection errors, 5xx status codes and 429 (too many requests) are
+// retried.
+func DefaultRetryClassifier(err error, resp *http.Response) bool {
+	if err != nil {
+		return true
+	}
+
+	return resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+}
+
+// executeRequest sends the JSON-RPC request and decodes the response into model. When a
+// timeout is set with WithTimeout or WithMethodTimeout, the request is abandoned once it
+// has passed.
+func (c Client) executeRequest(method string, bodyParameters []interface{}, model interface{}) error {
+	return c.forMethods(method).withTimeout(c.timeoutFor(method), func(c Client) error {
+		return c.doRequest(method, bodyParameters, model)
+	})
+}
+
+// forMethods returns a copy of the Client for a request calling the methods, which does
+// not retry the request when any of them is not idempotent, and only sends it to the
+// Client's node when any of them acts on the node's wallet.
+func (c Client) forMethods(methods ...string) Client {
+	for _, method := range methods {
+		if nonIdempotentMethods[method] {
+			c.maxRetries = 0
 		}
-	} else {
-		body, err = request.NewBodyWithParameters(method, bodyParameters)
-		if err != nil {
-			return err
+
+		if walletMethods[method] && len(c.nodeURIs) > 1 {
+			c = c.forNode(c.Node)
+		}
+	}
+
+	return c
+}
+
+// timeoutFor returns the timeout of a request calling the methods, which is the longest
+// of their timeouts, set with WithMethodTimeout or else WithTimeout. 0 means there is no
+// timeout.
+func (c Client) timeoutFor(methods ...string) time.Duration {
+	longest := time.Duration(0)
+
+	for _, method := range methods {
+		timeout, ok := c.methodTimeouts[method]
+		if !ok {
+			timeout = c.timeout
+		}
+
+		if timeout <= 0 {
+			return 0
+		}
+
+		if timeout > longest {
+			longest = timeout
 		}
 	}
 
-	ioBody := bytes.NewReader(body)
+	return longest
+}
+
+// withTimeout calls fn with a copy of the Client whose requests to each node are
+// abandoned once the timeout has passed, see sendRequestToNode.
+func (c Client) withTimeout(timeout time.Duration, fn func(c Client) error) error {
+	c.attemptTimeout = timeout
+	return fn(c)
+}
+
+func (c Client) doRequest(method string, bodyParameters []interface{}, model interface{}) error {
+	if bodyParameters == nil {
+		bodyParameters = []interface{}{}
+	}
 
-	request, err := http.NewRequest("POST", nodeURI, ioBody)
+	body := getBuffer()
+	defer putBuffer(body)
+
+	err := request.EncodeBody(body, method, bodyParameters)
 	if err != nil {
 		return err
 	}
 
-	client := http.Client{}
-
-	response, err := client.Do(request)
+	response, err := c.sendRequest(body.Bytes())
 	if err != nil {
 		return err
 	}
@@ -50,24 +193,234 @@ func executeRequest(method string, bodyParameters []interface{}, nodeURI string,
 		)
 	}
 
-	bytes, err := ioutil.ReadAll(response.Body)
+	responseBody := getBuffer()
+	defer putBuffer(responseBody)
+
+	_, err = responseBody.ReadFrom(response.Body)
 	if err != nil {
 		return err
 	}
+	responseBytes := responseBody.Bytes()
 
-	err = json.Unmarshal(bytes, &model)
+	err = json.Unmarshal(responseBytes, model)
 	if err != nil {
 		return err
 	}
 
-	// handle error response info
+	// handle error response info, only decoding the response again when it has an error
+	if !bytes.Contains(responseBytes, errorKey) {
+		return nil
+	}
+
 	var errorResp resp.Error
-	err = json.Unmarshal(bytes, &errorResp)
+	err = json.Unmarshal(responseBytes, &errorResp)
 	if err != nil {
 		return err
 	} else if errorResp.Error.Message != "" {
-		return errors.Errorf("error code: %v, error message: %v", errorResp.Error.Code, errorResp.Error.Message)
+		return RPCError{
+			Code:    errorResp.Error.Code,
+			Message: errorResp.Error.Message,
+		}
 	}
 
 	return nil
 }
+
+// WithContext returns a copy of the Client whose requests are sent with ctx, so every
+// method called on the copy is aborted when ctx is cancelled or its deadline passes, even
+// while the node is still responding. The error returned is then ctx.Err(), possibly
+// wrapped by the HTTP client. The Client shares its caches and node state with the copy.
+func (c Client) WithContext(ctx context.Context) Client {
+	c.ctx = ctx
+	return c
+}
+
+// context returns the context requests are sent with, set with WithContext.
+func (c Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+
+	return c.ctx
+}
+
+func newDefaultTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerNode
+
+	return transport
+}
+
+// getHTTPClient returns the HTTP client used to send requests to the nodes.
+func (c Client) getHTTPClient() *http.Client {
+	if c.httpClient != nil {
+		return c.httpClient
+	}
+
+	return defaultHTTPClient
+}
+
+func getBuffer() *bytes.Buffer {
+	buffer := bufferPool.Get().(*bytes.Buffer)
+	buffer.Reset()
+
+	return buffer
+}
+
+func putBuffer(buffer *bytes.Buffer) {
+	if buffer.Cap() > maxPooledBufferSize {
+		return
+	}
+
+	bufferPool.Put(buffer)
+}
+
+// sendRequest POSTs the body to the node. When the circuit breaker is enabled, nodes
+// whose breaker is open are skipped and a failing node is failed over to the next
+// configured node.
+func (c Client) sendRequest(body []byte) (*http.Response, error) {
+	if c.circuitBreakers == nil {
+		return c.sendRequestToNode(c.Node, body)
+	}
+
+	var lastErr error
+
+	for _, nodeURI := range c.candidateNodes() {
+		if !c.circuitBreakers.allow(nodeURI) {
+			continue
+		}
+
+		response, err := c.sendRequestToNode(nodeURI, body)
+		if ctxErr := c.context().Err(); ctxErr != nil {
+			// the node did not fail, the request was abandoned
+			if err == nil {
+				response.Body.Close()
+			}
+
+			c.circuitBreakers.abandon(nodeURI)
+			return nil, ctxErr
+		}
+
+		if err == nil && response.StatusCode == 200 {
+			c.circuitBreakers.record(nodeURI, true)
+			c.nodeChange.notify(nodeURI)
+			return response, nil
+		}
+
+		c.circuitBreakers.record(nodeURI, false)
+
+		if err == nil {
+			_, _ = ioutil.ReadAll(response.Body)
+			response.Body.Close()
+
+			err = fmt.Errorf(
+				"non-200 status code returned from NEO node, got: '%d'",
+				response.StatusCode,
+			)
+		}
+		lastErr = err
+	}
+
+	if lastErr == nil {
+		return nil, ErrAllNodesUnavailable
+	}
+
+	return nil, lastErr
+}
+
+// sendRequestToNode POSTs the body to the given node. When a timeout is set the request
+// to the node, including any retries and reading the response body, is abandoned once it
+// has passed, which is independent of the Client's context so that a node which hangs is
+// failed over like any other failing node. The error returned then wraps
+// context.DeadlineExceeded.
+func (c Client) sendRequestToNode(nodeURI string, body []byte) (*http.Response, error) {
+	if c.attemptTimeout <= 0 {
+		return c.sendAttempts(c.context(), nodeURI, body)
+	}
+
+	parent := c.context()
+	ctx, cancel := context.WithTimeout(parent, c.attemptTimeout)
+
+	response, err := c.sendAttempts(ctx, nodeURI, body)
+	if err != nil {
+		cancel()
+		return nil, c.timeoutError(ctx, parent, err)
+	}
+
+	response.Body = &timeoutBody{
+		ReadCloser: response.Body,
+		client:     c,
+		ctx:        ctx,
+		parent:     parent,
+		cancel:     cancel,
+	}
+
+	return response, nil
+}
+
+// sendAttempts POSTs the body to the given node with ctx. When retries are enabled,
+// failed attempts which the retry classifier deems retryable are sent again after the
+// retry delay, unless ctx is done first.
+func (c Client) sendAttempts(ctx context.Context, nodeURI string, body []byte) (*http.Response, error) {
+	classifier := c.retryClassifier
+	if classifier == nil {
+		classifier = DefaultRetryClassifier
+	}
+
+	for attempt := 0; ; attempt++ {
+		request, err := http.NewRequestWithContext(ctx, "POST", nodeURI, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := c.getHTTPClient().Do(request)
+		if err == nil {
+			c.responseHeaders.set(response.Header)
+		}
+
+		retryable := (err != nil || response.StatusCode != 200) && classifier(err, response)
+		if attempt >= c.maxRetries || !retryable {
+			return response, err
+		}
+
+		if response != nil {
+			_, _ = ioutil.ReadAll(response.Body)
+			response.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.retryDelay):
+		}
+	}
+}
+
+// timeoutError replaces err with one wrapping context.DeadlineExceeded when it was caused
+// by the timeout of the request, ctx, passing rather than by parent, the Client's
+// context, being done.
+func (c Client) timeoutError(ctx, parent context.Context, err error) error {
+	if ctx.Err() == context.DeadlineExceeded && parent.Err() == nil {
+		return errors.Wrapf(context.DeadlineExceeded, "request to NEO node timed out after %s", c.attemptTimeout)
+	}
+
+	return err
+}
+
+// Read implements the io.Reader interface.
+func (b *timeoutBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF {
+		err = b.client.timeoutError(b.ctx, b.parent, err)
+	}
+
+	return n, err
+}
+
+// Close implements the io.Closer interface.
+func (b *timeoutBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+
+	return err
+}
@@ -0,0 +1,446 @@
+package neo_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFlakyNode returns a server which responds with the given status codes in turn, and
+// then with a successful getblockcount result.
+func newFlakyNode(statusCodes ...int) (*httptest.Server, *int32) {
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(atomic.AddInt32(&calls, 1))
+		if call <= len(statusCodes) {
+			w.WriteHeader(statusCodes[call-1])
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": 1, "jsonrpc": "2.0", "result": 100}`))
+	}))
+
+	return server, &calls
+}
+
+// newConnectionCountingNode returns a server which responds with a successful
+// getblockcount result, and counts the connections opened to it.
+func newConnectionCountingNode() (*httptest.Server, *int32) {
+	var connections int32
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": 1, "jsonrpc": "2.0", "result": 100}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&connections, 1)
+		}
+	}
+	server.Start()
+
+	return server, &connections
+}
+
+// roundTripperFunc adapts a function to the http.RoundTripper interface.
+type roundTripperFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// newHangingNode returns a server which does not respond until the request is abandoned.
+func newHangingNode() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the body is read so that the server notices when the client disconnects
+		_, _ = ioutil.ReadAll(r.Body)
+		<-r.Context().Done()
+	}))
+}
+
+func TestRequest(t *testing.T) {
+	t.Run("Retries", func(t *testing.T) {
+		t.Run("Disabled", func(t *testing.T) {
+			server, calls := newFlakyNode(http.StatusServiceUnavailable)
+			defer server.Close()
+
+			client := neo.NewClient(server.URL)
+
+			_, err := client.GetBlockCount()
+			assert.Error(t, err)
+			assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+		})
+
+		t.Run("DefaultClassifier", func(t *testing.T) {
+			server, calls := newFlakyNode(
+				http.StatusServiceUnavailable,
+				http.StatusTooManyRequests,
+				http.StatusInternalServerError,
+			)
+			defer server.Close()
+
+			client := neo.NewClient(server.URL, neo.WithRetries(3, 0))
+
+			blockCount, err := client.GetBlockCount()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100), blockCount)
+			assert.Equal(t, int32(4), atomic.LoadInt32(calls))
+		})
+
+		t.Run("MaxRetriesReached", func(t *testing.T) {
+			server, calls := newFlakyNode(
+				http.StatusServiceUnavailable,
+				http.StatusServiceUnavailable,
+				http.StatusServiceUnavailable,
+			)
+			defer server.Close()
+
+			client := neo.NewClient(server.URL, neo.WithRetries(2, 0))
+
+			_, err := client.GetBlockCount()
+			assert.Error(t, err)
+			assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+		})
+
+		t.Run("DefaultClassifierNotRetryable", func(t *testing.T) {
+			server, calls := newFlakyNode(http.StatusBadRequest)
+			defer server.Close()
+
+			client := neo.NewClient(server.URL, neo.WithRetries(3, 0))
+
+			_, err := client.GetBlockCount()
+			assert.Error(t, err)
+			assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+		})
+
+		t.Run("CustomClassifier", func(t *testing.T) {
+			classifier := func(err error, resp *http.Response) bool {
+				return err == nil && resp.StatusCode == http.StatusServiceUnavailable
+			}
+
+			t.Run("Retryable", func(t *testing.T) {
+				server, calls := newFlakyNode(http.StatusServiceUnavailable)
+				defer server.Close()
+
+				client := neo.NewClient(
+					server.URL,
+					neo.WithRetries(3, 0),
+					neo.WithRetryClassifier(classifier),
+				)
+
+				_, err := client.GetBlockCount()
+				assert.NoError(t, err)
+				assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+			})
+
+			t.Run("NotRetryable", func(t *testing.T) {
+				server, calls := newFlakyNode(http.StatusInternalServerError)
+				defer server.Close()
+
+				client := neo.NewClient(
+					server.URL,
+					neo.WithRetries(3, 0),
+					neo.WithRetryClassifier(classifier),
+				)
+
+				_, err := client.GetBlockCount()
+				assert.Error(t, err)
+				assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+			})
+		})
+
+		t.Run("NonIdempotentMethod", func(t *testing.T) {
+			server, calls := newFlakyNode(http.StatusBadGateway)
+			defer server.Close()
+
+			client := neo.NewClient(server.URL, neo.WithRetries(3, 0))
+
+			_, err := client.SendToAddress(neo.GASAssetID, testAccounts[0].publicAddress, 1)
+			assert.Error(t, err)
+			assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+		})
+	})
+
+	t.Run(".WithContext()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			server, calls := newFlakyNode()
+			defer server.Close()
+
+			client := neo.NewClient(server.URL).WithContext(context.Background())
+
+			blockCount, err := client.GetBlockCount()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100), blockCount)
+			assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+		})
+
+		t.Run("AbortsInFlightRequest", func(t *testing.T) {
+			server := newHangingNode()
+			defer server.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			client := neo.NewClient(server.URL)
+
+			start := time.Now()
+			_, err := client.WithContext(ctx).GetBlockCount()
+			assert.True(t, errors.Is(err, context.DeadlineExceeded))
+			assert.True(t, time.Since(start) < time.Second)
+		})
+
+		t.Run("AbortsRetryDelay", func(t *testing.T) {
+			server, calls := newFlakyNode(http.StatusServiceUnavailable)
+			defer server.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			client := neo.NewClient(server.URL, neo.WithRetries(3, time.Minute))
+
+			_, err := client.WithContext(ctx).GetBlockCount()
+			assert.Equal(t, context.DeadlineExceeded, err)
+			assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+		})
+
+		t.Run("CircuitBreaker", func(t *testing.T) {
+			server := newHangingNode()
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			client := neo.NewClient(server.URL, neo.WithCircuitBreaker(1, time.Minute))
+
+			_, err := client.WithContext(ctx).GetBlockCount()
+			assert.Equal(t, context.Canceled, err)
+
+			status := client.NodeStatuses()[0]
+			assert.Equal(t, neo.BreakerClosed, status.Breaker)
+			assert.Equal(t, 0, status.ConsecutiveFailures)
+		})
+	})
+
+	t.Run("ConnectionReuse", func(t *testing.T) {
+		t.Run("SingleNode", func(t *testing.T) {
+			server, connections := newConnectionCountingNode()
+			defer server.Close()
+
+			client := neo.NewClient(server.URL)
+
+			for i := 0; i < 5; i++ {
+				_, err := client.GetBlockCount()
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, int32(1), atomic.LoadInt32(connections))
+		})
+
+		t.Run("MultipleNodes", func(t *testing.T) {
+			server1, connections1 := newConnectionCountingNode()
+			defer server1.Close()
+
+			server2, connections2 := newConnectionCountingNode()
+			defer server2.Close()
+
+			client, err := neo.NewClientUsingMultipleNodes([]string{server1.URL, server2.URL})
+			assert.NoError(t, err)
+
+			for i := 0; i < 6; i++ {
+				client.Node = []string{server1.URL, server2.URL}[i%2]
+
+				_, err := client.GetBlockCount()
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, int32(1), atomic.LoadInt32(connections1))
+			assert.Equal(t, int32(1), atomic.LoadInt32(connections2))
+		})
+	})
+
+	t.Run("WithTimeout()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			server, _ := newFlakyNode()
+			defer server.Close()
+
+			client := neo.NewClient(server.URL, neo.WithTimeout(time.Minute))
+
+			blockCount, err := client.GetBlockCount()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100), blockCount)
+			assert.Equal(t, time.Minute, client.Config().Timeout)
+		})
+
+		t.Run("TimesOut", func(t *testing.T) {
+			server := newHangingNode()
+			defer server.Close()
+
+			client := neo.NewClient(server.URL, neo.WithTimeout(50*time.Millisecond))
+
+			start := time.Now()
+			_, err := client.GetBlockCount()
+			assert.True(t, errors.Is(err, context.DeadlineExceeded))
+			assert.Contains(t, err.Error(), "timed out after 50ms")
+			assert.True(t, time.Since(start) < time.Second)
+		})
+
+		t.Run("CancelledContext", func(t *testing.T) {
+			server := newHangingNode()
+			defer server.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			client := neo.NewClient(server.URL, neo.WithTimeout(time.Minute))
+
+			_, err := client.WithContext(ctx).GetBlockCount()
+			assert.True(t, errors.Is(err, context.Canceled))
+		})
+	})
+
+	t.Run("WithMethodTimeout()", func(t *testing.T) {
+		t.Run("Stricter", func(t *testing.T) {
+			server := newHangingNode()
+			defer server.Close()
+
+			client := neo.NewClient(
+				server.URL,
+				neo.WithTimeout(time.Minute),
+				neo.WithMethodTimeout("getblockcount", 50*time.Millisecond),
+			)
+
+			start := time.Now()
+			_, err := client.GetBlockCount()
+			assert.True(t, errors.Is(err, context.DeadlineExceeded))
+			assert.Contains(t, err.Error(), "timed out after 50ms")
+			assert.True(t, time.Since(start) < time.Second)
+		})
+
+		t.Run("MoreTolerant", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(100 * time.Millisecond)
+				_, _ = w.Write([]byte(`{"id": 1, "jsonrpc": "2.0", "result": 100}`))
+			}))
+			defer server.Close()
+
+			client := neo.NewClient(
+				server.URL,
+				neo.WithTimeout(20*time.Millisecond),
+				neo.WithMethodTimeout("getblockcount", time.Minute),
+			)
+
+			blockCount, err := client.GetBlockCount()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100), blockCount)
+
+			_, err = client.GetBestBlockHash()
+			assert.True(t, errors.Is(err, context.DeadlineExceeded))
+			assert.Contains(t, err.Error(), "timed out after 20ms")
+
+			assert.Equal(t, map[string]time.Duration{"getblockcount": time.Minute}, client.Config().MethodTimeouts)
+		})
+	})
+
+	t.Run("WithHTTPClient()", func(t *testing.T) {
+		server, calls := newFlakyNode()
+		defer server.Close()
+
+		var roundTrips int32
+		httpClient := &http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				atomic.AddInt32(&roundTrips, 1)
+				return http.DefaultTransport.RoundTrip(r)
+			}),
+		}
+
+		client := neo.NewClient(server.URL, neo.WithHTTPClient(httpClient))
+
+		for i := 0; i < 2; i++ {
+			_, err := client.GetBlockCount()
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&roundTrips))
+		assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+		assert.True(t, client.Config().CustomHTTPClient)
+		assert.False(t, neo.NewClient(server.URL).Config().CustomHTTPClient)
+	})
+
+	t.Run("DefaultRetryClassifier()", func(t *testing.T) {
+		assert.True(t, neo.DefaultRetryClassifier(assert.AnError, nil))
+		assert.True(t, neo.DefaultRetryClassifier(nil, &http.Response{StatusCode: 502}))
+		assert.True(t, neo.DefaultRetryClassifier(nil, &http.Response{StatusCode: 429}))
+		assert.False(t, neo.DefaultRetryClassifier(nil, &http.Response{StatusCode: 404}))
+	})
+}
+
+func BenchmarkRequest(b *testing.B) {
+	newStaticNode := func(result string) *httptest.Server {
+		body := []byte(`{"id": 1, "jsonrpc": "2.0", "result": ` + result + `}`)
+
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(body)
+		}))
+	}
+
+	b.Run("GetBlockCount", func(b *testing.B) {
+		server := newStaticNode("1511369")
+		defer server.Close()
+
+		client := neo.NewClient(server.URL)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			if _, err := client.GetBlockCount(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("GetBlockByHash", func(b *testing.B) {
+		server := newStaticNode(testBlockJSON)
+		defer server.Close()
+
+		client := neo.NewClient(server.URL)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			if _, err := client.GetBlockByHash(testBlocks[0].hash); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("GetBlockCountParallel", func(b *testing.B) {
+		server := newStaticNode("1511369")
+		defer server.Close()
+
+		client := neo.NewClient(server.URL)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := client.GetBlockCount(); err != nil {
+					// b.Fatal must not be called from the RunParallel goroutines
+					b.Error(err)
+					return
+				}
+			}
+		})
+	})
+}
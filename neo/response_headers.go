@@ -0,0 +1,65 @@
+package neo
+
+import (
+	"net/http"
+	"sync"
+)
+
+type (
+	// responseHeaders holds the headers of the last response received from a node. It is
+	// shared by copies of the Client which created it, so all access is guarded by the
+	// mutex.
+	responseHeaders struct {
+		mutex  sync.Mutex
+		header http.Header
+	}
+)
+
+// sensitiveHeaders are redacted from captured response headers, as they may carry
+// credentials or session tokens.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Set-Cookie",
+	"WWW-Authenticate",
+}
+
+// LastResponseHeaders returns the HTTP headers of the last response received from a node,
+// such as the X-RateLimit-Remaining and X-Request-Id headers set by some providers, to
+// help diagnose throttling. Headers which may carry credentials have their values
+// replaced with "REDACTED". Headers are only captured when the Client was created with
+// WithResponseHeaders, otherwise nil is returned, as it is before any response.
+func (c Client) LastResponseHeaders() http.Header {
+	return c.responseHeaders.get()
+}
+
+func (r *responseHeaders) get() http.Header {
+	if r == nil {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.header.Clone()
+}
+
+func (r *responseHeaders) set(header http.Header) {
+	if r == nil {
+		return
+	}
+
+	header = header.Clone()
+	for _, key := range sensitiveHeaders {
+		if _, ok := header[key]; ok {
+			header[key] = []string{"REDACTED"}
+		}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.header = header
+}
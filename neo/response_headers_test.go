@@ -0,0 +1,69 @@
+package neo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseHeaders(t *testing.T) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := atomic.AddInt32(&requests, 1)
+
+		w.Header().Set("X-Request-Id", strconv.Itoa(int(request)))
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(100-int(request)))
+		w.Header().Set("Set-Cookie", "session=secret")
+		_, _ = w.Write([]byte(`{"id": 1, "jsonrpc": "2.0", "result": 100}`))
+	}))
+	defer server.Close()
+
+	t.Run(".LastResponseHeaders()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			client := neo.NewClient(server.URL, neo.WithResponseHeaders())
+			assert.Nil(t, client.LastResponseHeaders())
+
+			_, err := client.GetBlockCount()
+			assert.NoError(t, err)
+
+			first := client.LastResponseHeaders()
+
+			_, err = client.GetBlockCount()
+			assert.NoError(t, err)
+
+			headers := client.LastResponseHeaders()
+			assert.NotEqual(t, first.Get("X-Request-Id"), headers.Get("X-Request-Id"))
+			assert.Equal(t, strconv.Itoa(100-int(atomic.LoadInt32(&requests))), headers.Get("X-RateLimit-Remaining"))
+			assert.True(t, client.Config().ResponseHeaders)
+		})
+
+		t.Run("RedactsSensitiveHeaders", func(t *testing.T) {
+			client := neo.NewClient(server.URL, neo.WithResponseHeaders())
+
+			_, err := client.GetBlockCount()
+			assert.NoError(t, err)
+
+			headers := client.LastResponseHeaders()
+			assert.Equal(t, "REDACTED", headers.Get("Set-Cookie"))
+
+			// the returned headers are a copy
+			headers.Set("X-Request-Id", "changed")
+			assert.NotEqual(t, "changed", client.LastResponseHeaders().Get("X-Request-Id"))
+		})
+
+		t.Run("Disabled", func(t *testing.T) {
+			client := neo.NewClient(server.URL)
+
+			_, err := client.GetBlockCount()
+			assert.NoError(t, err)
+			assert.Nil(t, client.LastResponseHeaders())
+			assert.False(t, client.Config().ResponseHeaders)
+		})
+	})
+}
@@ -0,0 +1,66 @@
+package neo
+
+import (
+	"fmt"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+// DefaultSendManyChunkSize is the number of outputs placed in each transaction by
+// SendManyInChunks when no chunk size is given. At 60 bytes per output, 100 outputs keeps
+// a transaction well below the node's maximum transaction size.
+const DefaultSendManyChunkSize = 100
+
+type (
+	// SendManyChunkError is returned by SendManyInChunks when one of the chunks fails to
+	// send. Chunks are sent in order, so every chunk before Chunk has been sent and its
+	// transaction ID is held in TransactionIDs, no chunk after it has been attempted.
+	SendManyChunkError struct {
+		Chunk          int
+		TransactionIDs []string
+		Err            error
+	}
+)
+
+// Error implements the error interface.
+func (e SendManyChunkError) Error() string {
+	return fmt.Sprintf(
+		"sendmany failed for chunk %d (%d chunks sent): %s",
+		e.Chunk, len(e.TransactionIDs), e.Err,
+	)
+}
+
+// SendManyInChunks splits the outputs into chunks of at most chunkSize outputs and sends
+// each chunk as its own transaction using SendMany. If chunkSize is 0 or less then
+// DefaultSendManyChunkSize is used. The transaction IDs are returned in chunk order.
+//
+// Sending stops at the first chunk that fails, the error returned is then a
+// SendManyChunkError holding the transaction IDs of the chunks which were sent. The
+// outputs still to be paid are outputs[err.Chunk*chunkSize:].
+func (c Client) SendManyInChunks(outputs []models.TransferOutput, chunkSize int) ([]string, error) {
+	if chunkSize <= 0 {
+		chunkSize = DefaultSendManyChunkSize
+	}
+
+	var transactionIDs []string
+
+	for start := 0; start < len(outputs); start += chunkSize {
+		end := start + chunkSize
+		if end > len(outputs) {
+			end = len(outputs)
+		}
+
+		txID, err := c.SendMany(outputs[start:end])
+		if err != nil {
+			return transactionIDs, SendManyChunkError{
+				Chunk:          start / chunkSize,
+				TransactionIDs: transactionIDs,
+				Err:            err,
+			}
+		}
+
+		transactionIDs = append(transactionIDs, txID)
+	}
+
+	return transactionIDs, nil
+}
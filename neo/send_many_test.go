@@ -0,0 +1,135 @@
+package neo_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendMany(t *testing.T) {
+	outputs := make([]models.TransferOutput, 5)
+	for i := range outputs {
+		outputs[i] = models.TransferOutput{
+			Asset:   "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b",
+			Value:   models.NewFixed8(1),
+			Address: testAccounts[i%len(testAccounts)].publicAddress,
+		}
+	}
+
+	t.Run(".SendMany()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendmany": testRawResult(`{"txid": "0x1"}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			txID, err := client.SendMany(outputs)
+			assert.NoError(t, err)
+			assert.Equal(t, "0x1", txID)
+
+			sent := node.Calls("sendmany")
+			assert.Len(t, sent, 1)
+
+			var sentOutputs []models.TransferOutput
+			assert.NoError(t, json.Unmarshal(sent[0].Params[0], &sentOutputs))
+			assert.Equal(t, outputs, sentOutputs)
+		})
+
+		t.Run("NoOutputs", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.SendMany(nil)
+			assert.Error(t, err)
+			assert.Empty(t, node.Calls("sendmany"))
+		})
+
+		t.Run("InvalidAddress", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			invalid := append([]models.TransferOutput{}, outputs...)
+			invalid[3].Address = "not an address"
+
+			_, err := client.SendMany(invalid)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "output 3")
+			assert.Empty(t, node.Calls("sendmany"))
+		})
+	})
+
+	t.Run(".SendManyInChunks()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			calls := 0
+			node := newTestNode(map[string]testHandler{
+				"sendmany": func([]json.RawMessage) (interface{}, *testRPCError) {
+					calls++
+					return map[string]string{"txid": fmt.Sprintf("0x%d", calls)}, nil
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			txIDs, err := client.SendManyInChunks(outputs, 2)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"0x1", "0x2", "0x3"}, txIDs)
+
+			sent := node.Calls("sendmany")
+			assert.Len(t, sent, 3)
+
+			var lastChunk []models.TransferOutput
+			assert.NoError(t, json.Unmarshal(sent[2].Params[0], &lastChunk))
+			assert.Equal(t, outputs[4:], lastChunk)
+		})
+
+		t.Run("DefaultChunkSize", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendmany": testRawResult(`{"txid": "0x1"}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			txIDs, err := client.SendManyInChunks(outputs, 0)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"0x1"}, txIDs)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			calls := 0
+			node := newTestNode(map[string]testHandler{
+				"sendmany": func([]json.RawMessage) (interface{}, *testRPCError) {
+					calls++
+					if calls == 2 {
+						return nil, &testRPCError{Code: -300, Message: "Insufficient funds"}
+					}
+					return map[string]string{"txid": fmt.Sprintf("0x%d", calls)}, nil
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			txIDs, err := client.SendManyInChunks(outputs, 2)
+			assert.Error(t, err)
+			assert.Equal(t, []string{"0x1"}, txIDs)
+			assert.Len(t, node.Calls("sendmany"), 2)
+
+			chunkErr, ok := err.(neo.SendManyChunkError)
+			assert.True(t, ok)
+			assert.Equal(t, 1, chunkErr.Chunk)
+			assert.Equal(t, []string{"0x1"}, chunkErr.TransactionIDs)
+		})
+	})
+}
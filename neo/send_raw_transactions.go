@@ -0,0 +1,147 @@
+package neo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models/response"
+	"github.com/pkg/errors"
+)
+
+// transactionPollInterval is how often SendRawTransactions checks whether a transaction
+// has reached the node.
+const transactionPollInterval = time.Second
+
+// ErrTransactionRejected is returned when the node rejects a raw transaction without
+// giving a reason, as older NEO2 nodes do.
+var ErrTransactionRejected = errors.New("transaction was rejected by the NEO node")
+
+// SendRawTransactions broadcasts the hex encoded, signed, transactions one at a time in
+// the order given, and returns their transaction IDs.
+//
+// Each transaction is only sent once the node has accepted the previous one. When
+// waitBetween is set, the node must also know of the previous transaction, in its
+// mempool or in a block, before the next is sent. This is needed when a transaction
+// spends an output of the one before it, otherwise the node can reject it for a missing
+// input.
+//
+// If a transaction fails to send no further transactions are sent, and the IDs of the
+// transactions sent so far are returned along with the error. When ctx is done, no
+// further transactions are sent and ctx.Err() is returned, with the IDs of the
+// transactions sent so far too.
+func (c Client) SendRawTransactions(ctx context.Context, hexTxs []string, waitBetween bool) ([]string, error) {
+	c = c.WithContext(ctx)
+	transactionIDs := make([]string, 0, len(hexTxs))
+
+	for i, hexTx := range hexTxs {
+		if err := ctx.Err(); err != nil {
+			return transactionIDs, err
+		}
+
+		if waitBetween && i > 0 {
+			if err := c.waitForTransaction(ctx, transactionIDs[i-1]); err != nil {
+				return transactionIDs, err
+			}
+		}
+
+		txID, err := c.sendRawTransaction(hexTx)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return transactionIDs, ctxErr
+			}
+
+			return transactionIDs, errors.Wrapf(err, "unable to send transaction %d", i)
+		}
+
+		transactionIDs = append(transactionIDs, txID)
+	}
+
+	return transactionIDs, nil
+}
+
+// SendRawTransaction broadcasts the hex encoded transaction, which was built and signed
+// without the node's wallet, and returns whether the node accepted it. When the node
+// rejects the transaction the error holds its reason, such as "Malformed transaction",
+// or is ErrTransactionRejected when the node does not give one.
+func (c Client) SendRawTransaction(hexTx string) (bool, error) {
+	result, err := c.postRawTransaction(hexTx)
+	if err != nil {
+		return false, err
+	}
+
+	var accepted bool
+	if err := json.Unmarshal(result, &accepted); err == nil && !accepted {
+		return false, ErrTransactionRejected
+	}
+
+	// NEO3 nodes return the hash of an accepted transaction rather than true
+	return true, nil
+}
+
+// sendRawTransaction broadcasts the hex encoded, signed, transaction and returns its
+// transaction ID. NEO2 nodes only report whether the transaction was accepted, so the ID
+// is then worked out from the transaction itself.
+func (c Client) sendRawTransaction(hexTx string) (string, error) {
+	result, err := c.postRawTransaction(hexTx)
+	if err != nil {
+		return "", err
+	}
+
+	var accepted bool
+	if err := json.Unmarshal(result, &accepted); err == nil {
+		if !accepted {
+			return "", ErrTransactionRejected
+		}
+
+		transaction, err := decodeRawTransaction(hexTx)
+		if err != nil {
+			return "", errors.Wrap(err, "transaction was sent, but its ID could not be worked out")
+		}
+
+		return transaction.Hash(), nil
+	}
+
+	var hash struct {
+		Hash string `json:"hash"`
+	}
+	if err := json.Unmarshal(result, &hash); err != nil {
+		return "", err
+	}
+
+	return hash.Hash, nil
+}
+
+// postRawTransaction calls sendrawtransaction, and returns the result, which is a boolean
+// on NEO2 and an object holding the hash of the transaction on NEO3.
+func (c Client) postRawTransaction(hexTx string) (json.RawMessage, error) {
+	requestBodyParams := []interface{}{
+		hexTx,
+	}
+	var resp response.SendRawTransaction
+
+	err := c.executeRequest("sendrawtransaction", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Result, nil
+}
+
+// waitForTransaction polls the node until it knows of the transaction, in its mempool or
+// in a block, or until ctx is done.
+func (c Client) waitForTransaction(ctx context.Context, txID string) error {
+	c = c.WithContext(ctx)
+
+	for {
+		if _, err := c.GetTransaction(txID); err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(transactionPollInterval):
+		}
+	}
+}
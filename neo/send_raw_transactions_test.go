@@ -0,0 +1,266 @@
+package neo_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendRawTransactions(t *testing.T) {
+	rawTransactions := []struct {
+		hex  string
+		hash string
+	}{
+		{
+			// MinerTransaction of the NEO2 MainNet genesis block
+			hex:  "00001dac2b7c000000",
+			hash: "0xfb5bd72b2d6792d75dc2f1084ffa9e9f70ca85543c717a6b13d9959b452a57d6",
+		},
+		{
+			// ContractTransaction with a remark, an input, an output and a witness
+			hex: "800001f002686901000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f" +
+				"0100019b7cffdaa674beae0f930ebe6085af9093e5fe56b34a5c220ccdcf6efc336fc500e1f50500" +
+				"000000000102030405060708090a0b0c0d0e0f101112130141400000000000000000000000000000" +
+				"00000000000000000000000000000000000000000000000000000000000000000000000000000000" +
+				"0000000000000000232102028a99826edc0c97d18e22b6932373d908d323aa7f92656a77ec26e886" +
+				"1699efac",
+			hash: "0xcec8843ea440110d867f3ffe120d7fbadc51fee43d8f432ca9577d1e6687ebb4",
+		},
+		{
+			// InvocationTransaction (version 1) with a script attribute
+			hex:  "d1010351529300000000000000000120000102030405060708090a0b0c0d0e0f10111213000000",
+			hash: "0x5db958a60de44243aef2a05414592432f2cd650677cd8497737ba705ee456a81",
+		},
+	}
+
+	hexTxs := make([]string, 0, len(rawTransactions))
+	hashes := make([]string, 0, len(rawTransactions))
+	for _, rawTransaction := range rawTransactions {
+		hexTxs = append(hexTxs, rawTransaction.hex)
+		hashes = append(hashes, rawTransaction.hash)
+	}
+
+	t.Run(".SendRawTransaction()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendrawtransaction": testResult(true),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			accepted, err := client.SendRawTransaction(hexTxs[0])
+			assert.NoError(t, err)
+			assert.True(t, accepted)
+
+			calls := node.Calls("sendrawtransaction")
+			assert.Len(t, calls, 1)
+			assert.Equal(t, `"`+hexTxs[0]+`"`, string(calls[0].Params[0]))
+		})
+
+		t.Run("NEO3Node", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendrawtransaction": testResult(map[string]string{"hash": hashes[0]}),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			accepted, err := client.SendRawTransaction("0011223344556677")
+			assert.NoError(t, err)
+			assert.True(t, accepted)
+		})
+
+		t.Run("Rejected", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendrawtransaction": testResult(false),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			accepted, err := client.SendRawTransaction(hexTxs[0])
+			assert.False(t, accepted)
+			assert.Equal(t, neo.ErrTransactionRejected, err)
+		})
+
+		t.Run("ErrorMessage", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendrawtransaction": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -500, Message: "Malformed transaction"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			accepted, err := client.SendRawTransaction("00")
+			assert.False(t, accepted)
+			assert.Equal(t, neo.RPCError{Code: -500, Message: "Malformed transaction"}, err)
+		})
+	})
+
+	t.Run(".SendRawTransactions()", func(t *testing.T) {
+		t.Run("NEO2Node", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendrawtransaction": testResult(true),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			transactionIDs, err := client.SendRawTransactions(context.Background(), hexTxs, false)
+			assert.NoError(t, err)
+			assert.Equal(t, hashes, transactionIDs)
+
+			calls := node.Calls("sendrawtransaction")
+			assert.Len(t, calls, 3)
+			for i, call := range calls {
+				assert.Equal(t, `"`+hexTxs[i]+`"`, string(call.Params[0]))
+			}
+		})
+
+		t.Run("NEO3Node", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendrawtransaction": func(params []json.RawMessage) (interface{}, *testRPCError) {
+					return map[string]string{"hash": "0x" + string(params[0][1:9])}, nil
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			transactionIDs, err := client.SendRawTransactions(context.Background(), []string{
+				"0011223344556677", "8899aabbccddeeff",
+			}, false)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"0x00112233", "0x8899aabb"}, transactionIDs)
+		})
+
+		t.Run("WaitBetween", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendrawtransaction": testResult(true),
+				"getrawtransaction":  testRawResult(`{"txid": "0x01", "confirmations": 0}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			transactionIDs, err := client.SendRawTransactions(context.Background(), hexTxs, true)
+			assert.NoError(t, err)
+			assert.Equal(t, hashes, transactionIDs)
+
+			calls := node.Calls("getrawtransaction")
+			assert.Len(t, calls, 2)
+			assert.Equal(t, `"`+hashes[0]+`"`, string(calls[0].Params[0]))
+			assert.Equal(t, `"`+hashes[1]+`"`, string(calls[1].Params[0]))
+		})
+
+		t.Run("WaitBetweenTimeout", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendrawtransaction": testResult(true),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			transactionIDs, err := client.SendRawTransactions(ctx, hexTxs, true)
+			assert.Equal(t, context.DeadlineExceeded, err)
+			assert.Equal(t, hashes[:1], transactionIDs)
+			assert.Len(t, node.Calls("sendrawtransaction"), 1)
+		})
+
+		t.Run("HangingNode", func(t *testing.T) {
+			node := newHangingNode()
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			transactionIDs, err := client.SendRawTransactions(ctx, hexTxs, false)
+			assert.Equal(t, context.DeadlineExceeded, err)
+			assert.Empty(t, transactionIDs)
+		})
+
+		t.Run("CancelledAfterSend", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			// the node accepts the first transaction, and ctx is cancelled as it does
+			transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				cancel()
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"id": 1, "jsonrpc": "2.0", "result": true}`)),
+				}, nil
+			})
+
+			client := neo.NewClient("http://localhost:10332", neo.WithHTTPClient(&http.Client{Transport: transport}))
+
+			transactionIDs, err := client.SendRawTransactions(ctx, hexTxs, false)
+			assert.Equal(t, context.Canceled, err)
+			assert.Equal(t, hashes[:1], transactionIDs)
+		})
+
+		t.Run("PartialFailure", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendrawtransaction": func(params []json.RawMessage) (interface{}, *testRPCError) {
+					if string(params[0]) == `"`+hexTxs[1]+`"` {
+						return nil, &testRPCError{Code: -505, Message: "InsufficientFunds"}
+					}
+
+					return true, nil
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			transactionIDs, err := client.SendRawTransactions(context.Background(), hexTxs, false)
+			assert.Equal(t, hashes[:1], transactionIDs)
+			assert.Equal(t, neo.RPCError{Code: -505, Message: "InsufficientFunds"}, errors.Cause(err))
+			assert.Len(t, node.Calls("sendrawtransaction"), 2)
+		})
+
+		t.Run("Rejected", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendrawtransaction": testResult(false),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			transactionIDs, err := client.SendRawTransactions(context.Background(), hexTxs, false)
+			assert.Empty(t, transactionIDs)
+			assert.Equal(t, neo.ErrTransactionRejected, errors.Cause(err))
+		})
+
+		t.Run("UndecodableTransaction", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"sendrawtransaction": testResult(true),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			for _, hexTx := range []string{"zz", "80", "0000", "ee00000000", "80000111"} {
+				_, err := client.SendRawTransactions(context.Background(), []string{hexTx}, false)
+				assert.Error(t, err, hexTx)
+			}
+		})
+	})
+}
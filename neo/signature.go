@@ -0,0 +1,101 @@
+package neo
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+)
+
+// SignMessage signs the message with the private key of the WIF, using the same
+// conventions as VerifySignature, and returns the hex encoded 64 byte signature. ECDSA
+// signatures are randomised, so signing the same message twice gives different
+// signatures which both verify.
+func SignMessage(message string, privKeyWIF string) (string, error) {
+	privateKey, err := NewPrivateKeyFromWIF(privKeyWIF)
+	if err != nil {
+		return "", err
+	}
+
+	curve := elliptic.P256()
+	d := new(big.Int).SetBytes(privateKey.bytes)
+	x, y := curve.ScalarBaseMult(privateKey.bytes)
+
+	key := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{Curve: curve, X: x, Y: y},
+		D:         d,
+	}
+
+	hash := sha256.Sum256([]byte(message))
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	if err != nil {
+		return "", err
+	}
+
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	return hex.EncodeToString(signature), nil
+}
+
+// VerifySignature checks the signature of the message against the public key, using
+// the same conventions as NEO: the message is hashed with SHA-256 and signed using ECDSA
+// on the secp256r1 curve, with the signature being the 64 byte concatenation of r and s.
+// The public key can be compressed (33 bytes) or uncompressed (65 bytes). False is
+// returned when the signature does not match, an error is only returned when the
+// signature or public key is malformed.
+func VerifySignature(message, signatureHex, pubKeyHex string) (bool, error) {
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return false, fmt.Errorf("Signature is not valid hex: %s", err)
+	}
+
+	if len(signature) != 64 {
+		return false, fmt.Errorf(
+			"Expected length of signature to be 64 bytes, got: %d", len(signature),
+		)
+	}
+
+	publicKey, err := parsePublicKey(pubKeyHex)
+	if err != nil {
+		return false, err
+	}
+
+	hash := sha256.Sum256([]byte(message))
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+
+	return ecdsa.Verify(publicKey, hash[:], r, s), nil
+}
+
+// parsePublicKey decodes a hex encoded, compressed or uncompressed, secp256r1 public key.
+func parsePublicKey(pubKeyHex string) (*ecdsa.PublicKey, error) {
+	bytes, err := hex.DecodeString(pubKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("Public key is not valid hex: %s", err)
+	}
+
+	curve := elliptic.P256()
+
+	var x, y *big.Int
+	switch len(bytes) {
+	case 33:
+		x, y = elliptic.UnmarshalCompressed(curve, bytes)
+	case 65:
+		x, y = elliptic.Unmarshal(curve, bytes)
+	default:
+		return nil, fmt.Errorf(
+			"Expected length of public key to be 33 or 65 bytes, got: %d", len(bytes),
+		)
+	}
+
+	if x == nil {
+		return nil, fmt.Errorf("Public key is not a point on the secp256r1 curve")
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
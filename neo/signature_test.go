@@ -0,0 +1,94 @@
+package neo_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignature(t *testing.T) {
+	message := "sign in to neo-go-sdk: 1511369"
+	signature := "b420e06fbf0185eb9d9557a153e2c2a0a9545c8dc48d0487ad140d70c15c9b85" +
+		"76758ca9685ff7fbefae8ef72e6548e8720ec417f8960d8a3544f67378d32da7"
+	publicKey := "02028a99826edc0c97d18e22b6932373d908d323aa7f92656a77ec26e8861699ef"
+	uncompressedPublicKey := "04028a99826edc0c97d18e22b6932373d908d323aa7f92656a77ec26e8861699ef" +
+		"35787439bef71cafeebeb4dfd8954d13470d3c383d59f491d98b079c5edbcc2e"
+
+	t.Run("SignMessage()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			for i, account := range testAccounts {
+				description := fmt.Sprintf("%d", i)
+				t.Run(description, func(t *testing.T) {
+					signature, err := neo.SignMessage(message, account.wif)
+					assert.NoError(t, err)
+					assert.Len(t, signature, 128)
+
+					valid, err := neo.VerifySignature(message, signature, account.publicKey)
+					assert.NoError(t, err)
+					assert.True(t, valid)
+				})
+			}
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			signature, err := neo.SignMessage(message, "invalid WIF")
+			assert.Error(t, err)
+			assert.Empty(t, signature)
+		})
+	})
+
+	t.Run("VerifySignature()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			valid, err := neo.VerifySignature(message, signature, publicKey)
+			assert.NoError(t, err)
+			assert.True(t, valid)
+		})
+
+		t.Run("UncompressedPublicKey", func(t *testing.T) {
+			valid, err := neo.VerifySignature(message, signature, uncompressedPublicKey)
+			assert.NoError(t, err)
+			assert.True(t, valid)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			t.Run("DifferentMessage", func(t *testing.T) {
+				valid, err := neo.VerifySignature(message+"0", signature, publicKey)
+				assert.NoError(t, err)
+				assert.False(t, valid)
+			})
+
+			t.Run("DifferentPublicKey", func(t *testing.T) {
+				valid, err := neo.VerifySignature(
+					message,
+					signature,
+					"02486fd15702c4490a26703112a5cc1d0923fd697a33406bd5a1c00e0013b09a70",
+				)
+				assert.NoError(t, err)
+				assert.False(t, valid)
+			})
+
+			t.Run("InvalidSignature", func(t *testing.T) {
+				valid, err := neo.VerifySignature(message, "zz", publicKey)
+				assert.Error(t, err)
+				assert.False(t, valid)
+
+				valid, err = neo.VerifySignature(message, signature[:64], publicKey)
+				assert.Error(t, err)
+				assert.False(t, valid)
+			})
+
+			t.Run("InvalidPublicKey", func(t *testing.T) {
+				valid, err := neo.VerifySignature(message, signature, publicKey[:64])
+				assert.Error(t, err)
+				assert.False(t, valid)
+
+				valid, err = neo.VerifySignature(message, signature, "02"+strings.Repeat("ff", 32))
+				assert.Error(t, err)
+				assert.False(t, valid)
+			})
+		})
+	})
+}
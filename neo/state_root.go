@@ -0,0 +1,188 @@
+package neo
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/lomocoin/neo-go-sdk/neo/models/response"
+)
+
+const (
+	// neoTokenContract and gasTokenContract are the script hashes of the NEO and GAS
+	// native contracts on NEO3.
+	neoTokenContract = "0xef4073a0f2b305a38ec4050e4d3d28bc40ea63f5"
+	gasTokenContract = "0xd2a4cff31913016155e38e474a2c06d08be276cf"
+
+	// nativeAccountPrefix is the storage key prefix of account balances in the NEO and
+	// GAS native contracts, it is followed by the account's script hash.
+	nativeAccountPrefix = 0x14
+
+	// rpcErrorCodeUnknownValue is returned by the StateService when a storage key does
+	// not exist at the state root.
+	rpcErrorCodeUnknownValue = -100
+
+	// rpcErrorCodeUnknownStorageItem is returned by NEO3 nodes from version 3.6 when a
+	// storage key does not exist, older nodes return rpcErrorCodeUnknownValue.
+	rpcErrorCodeUnknownStorageItem = -104
+)
+
+// ErrStateServiceUnavailable is returned by methods which read historical state when the
+// node does not have the StateService plugin installed.
+var ErrStateServiceUnavailable = errors.New("node does not have the state service plugin")
+
+// GetStorageAtRoot takes a state root hash, a smart contract hash and a storage key, and
+// returns the storage value as it was at that state root, allowing contract storage to be
+// read at a point in time. The value is returned hex encoded, in the same format as
+// GetStorage.
+//
+// It calls the getstate method of the StateService plugin, which takes the key and
+// returns the value base64 encoded. ErrStateServiceUnavailable is returned when the node
+// does not have the plugin.
+func (c Client) GetStorageAtRoot(rootHash, scriptHash, storageKey string) (string, error) {
+	value, err := c.getStateAtRoot(rootHash, scriptHash, []byte(storageKey))
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(value), nil
+}
+
+// GetBalanceAtHeight returns the NEO and GAS balances of the address as they were after
+// the block at height, for accounting snapshots. Balances of zero are left out.
+//
+// The balances are read from the storage of the NEO and GAS native contracts at the state
+// root of the block, which requires a NEO3 node with the StateService plugin. NEO2 nodes
+// do not keep historical balances, so ErrStateServiceUnavailable is returned for them,
+// as it is when the plugin is not installed. The current balance is never returned in
+// place of the historical one.
+func (c Client) GetBalanceAtHeight(address string, height int64) (*models.AccountState, error) {
+	if err := c.checkNetworkGeneration(NEO3); err != nil {
+		return nil, ErrStateServiceUnavailable
+	}
+
+	_, scriptHash, err := decodeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	stateRoot, err := c.getStateRoot(height)
+	if err != nil {
+		return nil, err
+	}
+
+	key := append([]byte{nativeAccountPrefix}, scriptHash...)
+
+	state := &models.AccountState{
+		ScriptHash: "0x" + hex.EncodeToString(reverseBytes(scriptHash)),
+		Balances:   []models.AccountBalance{},
+	}
+
+	for _, asset := range []struct {
+		contract string
+		decimals int64
+	}{
+		{contract: neoTokenContract, decimals: 0},
+		{contract: gasTokenContract, decimals: 8},
+	} {
+		value, err := c.getStateAtRoot(stateRoot.RootHash, asset.contract, key)
+		if rpcErr, ok := err.(RPCError); ok {
+			switch rpcErr.Code {
+			case rpcErrorCodeUnknownValue, rpcErrorCodeUnknownStorageItem:
+				// the account has never held the asset
+				continue
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		balance, err := accountStateBalance(value)
+		if err != nil {
+			return nil, err
+		}
+
+		// scale the balance from the token's decimals to 8 decimal places
+		balance.Mul(balance, new(big.Int).Exp(big.NewInt(10), big.NewInt(8-asset.decimals), nil))
+		if !balance.IsInt64() {
+			return nil, fmt.Errorf("balance of %s is out of range: %s", asset.contract, balance)
+		}
+
+		if balance.Sign() != 0 {
+			state.Balances = append(state.Balances, models.AccountBalance{
+				Asset: asset.contract,
+				Value: models.Fixed8(balance.Int64()),
+			})
+		}
+	}
+
+	return state, nil
+}
+
+// getStateRoot returns the state root after the block at index.
+func (c Client) getStateRoot(index int64) (*models.StateRoot, error) {
+	requestBodyParams := []interface{}{
+		index,
+	}
+	var resp response.StateRoot
+
+	err := c.executeRequest("getstateroot", requestBodyParams, &resp)
+	if err != nil {
+		if rpcErr, ok := err.(RPCError); ok && rpcErr.Code == rpcErrorCodeMethodNotFound {
+			return nil, ErrStateServiceUnavailable
+		}
+
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// getStateAtRoot reads the storage key of the smart contract at the state root using the
+// getstate method of the StateService plugin.
+func (c Client) getStateAtRoot(rootHash, scriptHash string, key []byte) ([]byte, error) {
+	requestBodyParams := []interface{}{
+		rootHash, scriptHash, base64.StdEncoding.EncodeToString(key),
+	}
+	var resp response.String
+
+	err := c.executeRequest("getstate", requestBodyParams, &resp)
+	if err != nil {
+		if rpcErr, ok := err.(RPCError); ok && rpcErr.Code == rpcErrorCodeMethodNotFound {
+			return nil, ErrStateServiceUnavailable
+		}
+
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(resp.Result)
+}
+
+// accountStateBalance decodes the balance from the binary serialized account state of a
+// native token, which is a struct whose first item is the balance integer.
+func accountStateBalance(value []byte) (*big.Int, error) {
+	// struct (0x41), item count, integer (0x21), integer length
+	if len(value) < 4 || value[0] != 0x41 || value[1] == 0 || value[2] != 0x21 {
+		return nil, errors.New("Unexpected format of native token account state")
+	}
+
+	length := int(value[3])
+	if len(value) < 4+length {
+		return nil, errors.New("Unexpected format of native token account state")
+	}
+
+	return models.IntegerFromLittleEndian(value[4 : 4+length]), nil
+}
+
+// reverseBytes returns a reversed copy of the bytes.
+func reverseBytes(bytes []byte) []byte {
+	reversed := make([]byte, len(bytes))
+	for i, b := range bytes {
+		reversed[len(bytes)-1-i] = b
+	}
+
+	return reversed
+}
@@ -0,0 +1,167 @@
+package neo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateRoot(t *testing.T) {
+	rootHash := "0x7e4bb0e5d3a1fe7bb6bbbf8cfb3c7e1f86e5e49c2c5cba6e62e2b5d2ebd3d4f5"
+	scriptHash := "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9"
+
+	t.Run(".GetStorageAtRoot()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getstate": testResult("AHLvPiWX4gE="),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			value, err := client.GetStorageAtRoot(rootHash, scriptHash, "totalSupply")
+			assert.NoError(t, err)
+			assert.Equal(t, "0072ef3e2597e201", value)
+
+			params := node.Calls("getstate")[0].Params
+			var key string
+			assert.NoError(t, json.Unmarshal(params[2], &key))
+			assert.Equal(t, "dG90YWxTdXBwbHk=", key)
+		})
+
+		t.Run("StateServiceUnavailable", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			value, err := client.GetStorageAtRoot(rootHash, scriptHash, "totalSupply")
+			assert.Equal(t, neo.ErrStateServiceUnavailable, err)
+			assert.Empty(t, value)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getstate": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -100, Message: "Unknown value"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetStorageAtRoot(rootHash, scriptHash, "totalSupply")
+			assert.Equal(t, neo.RPCError{Code: -100, Message: "Unknown value"}, err)
+		})
+	})
+
+	t.Run(".GetBalanceAtHeight()", func(t *testing.T) {
+		address := "NPTmAHDxo6Pkyic8Nvu3kwyXoYJCvcCB6i"
+		neo3Version := testRawResult(`{"tcpport": 10333, "useragent": "/Neo:3.0.3/"}`)
+		stateRootHandler := testRawResult(`{"version": 0, "index": 160, "roothash": "` + rootHash + `", "witnesses": []}`)
+
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":   neo3Version,
+				"getstateroot": stateRootHandler,
+				"getstate": func(params []json.RawMessage) (interface{}, *testRPCError) {
+					var contract string
+					_ = json.Unmarshal(params[1], &contract)
+
+					if contract == "0xef4073a0f2b305a38ec4050e4d3d28bc40ea63f5" {
+						return "QQQhAWQhAQUAIQA=", nil
+					}
+
+					return "QQEhBIDR8Ag=", nil
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			state, err := client.GetBalanceAtHeight(address, 160)
+			assert.NoError(t, err)
+			assert.Equal(t, &models.AccountState{
+				ScriptHash: "0xa7cbfee3f01f89d58c042644b0b6df2d59a6eb26",
+				Balances: []models.AccountBalance{
+					{Asset: "0xef4073a0f2b305a38ec4050e4d3d28bc40ea63f5", Value: models.NewFixed8(100)},
+					{Asset: "0xd2a4cff31913016155e38e474a2c06d08be276cf", Value: models.Fixed8(150000000)},
+				},
+			}, state)
+
+			assert.Equal(t, "160", string(node.Calls("getstateroot")[0].Params[0]))
+
+			calls := node.Calls("getstate")
+			assert.Len(t, calls, 2)
+			assert.Equal(t, `"`+rootHash+`"`, string(calls[0].Params[0]))
+			assert.Equal(t, `"FCbrplkt37awRCYEjNWJH/Dj/sun"`, string(calls[0].Params[2]))
+		})
+
+		t.Run("NoBalance", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":   neo3Version,
+				"getstateroot": stateRootHandler,
+				"getstate": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -100, Message: "Unknown value"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			state, err := client.GetBalanceAtHeight(address, 160)
+			assert.NoError(t, err)
+			assert.Empty(t, state.Balances)
+		})
+
+		t.Run("UnknownStorageItem", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":   neo3Version,
+				"getstateroot": stateRootHandler,
+				"getstate": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -104, Message: "Unknown storage item"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			state, err := client.GetBalanceAtHeight(address, 160)
+			assert.NoError(t, err)
+			assert.Empty(t, state.Balances)
+		})
+
+		t.Run("StateServiceUnavailable", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": neo3Version,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetBalanceAtHeight(address, 160)
+			assert.Equal(t, neo.ErrStateServiceUnavailable, err)
+		})
+
+		t.Run("NEO2Node", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithNetworkGeneration(neo.NEO2))
+
+			_, err := client.GetBalanceAtHeight(testAccounts[0].publicAddress, 160)
+			assert.Equal(t, neo.ErrStateServiceUnavailable, err)
+			assert.Empty(t, node.Calls("getstateroot"))
+		})
+
+		t.Run("InvalidAddress", func(t *testing.T) {
+			client := neo.NewClient("http://localhost:1", neo.WithNetworkGeneration(neo.NEO3))
+
+			_, err := client.GetBalanceAtHeight("NPTmAHDxo6Pkyic8Nvu3kwyXoYJCvcCB6j", 160)
+			assert.Error(t, err)
+		})
+	})
+}
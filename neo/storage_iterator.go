@@ -0,0 +1,124 @@
+package neo
+
+import (
+	"encoding/base64"
+	"errors"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/lomocoin/neo-go-sdk/neo/models/response"
+)
+
+type (
+	// StorageIterator iterates over the storage entries of a smart contract under a key
+	// prefix, fetching them from the node a page at a time. It is used in the same way as
+	// a bufio.Scanner:
+	//
+	//	iterator := client.IterateStorage(scriptHash, prefix)
+	//	defer iterator.Close()
+	//
+	//	for iterator.Next() {
+	//		entry := iterator.Entry()
+	//	}
+	//
+	//	if err := iterator.Err(); err != nil {
+	//	}
+	StorageIterator struct {
+		client     Client
+		scriptHash string
+		prefix     string
+		page       []models.StorageEntry
+		entry      models.StorageEntry
+		next       int
+		done       bool
+		err        error
+	}
+)
+
+// ErrStorageIterationUnavailable is returned by StorageIterator.Err when the node does not
+// support finding storage by prefix, which requires NEO 3.5 or later.
+var ErrStorageIterationUnavailable = errors.New("node does not support finding storage by prefix")
+
+// IterateStorage returns an iterator over the storage entries of the smart contract whose
+// keys start with prefix, in key order. The entries are found with the findstorage method
+// of NEO3 nodes, and the pages it returns are fetched as the iterator advances. For NEO2
+// nodes the iterator fails with ErrUnsupportedNetworkGeneration, and when the node does
+// not have the findstorage method it fails with ErrStorageIterationUnavailable.
+func (c Client) IterateStorage(scriptHash, prefix string) *StorageIterator {
+	iterator := &StorageIterator{
+		client:     c,
+		scriptHash: scriptHash,
+		prefix:     prefix,
+	}
+
+	if err := c.checkNetworkGeneration(NEO3); err != nil {
+		iterator.fail(err)
+	}
+
+	return iterator
+}
+
+// Next advances the iterator to the next entry, which is then available from Entry. It
+// returns false when there are no more entries or an error occurred, see Err.
+func (i *StorageIterator) Next() bool {
+	for len(i.page) == 0 {
+		if i.done {
+			return false
+		}
+
+		if err := i.fetch(); err != nil {
+			i.fail(err)
+			return false
+		}
+	}
+
+	i.entry = i.page[0]
+	i.page = i.page[1:]
+
+	return true
+}
+
+// Entry returns the entry the iterator is at.
+func (i *StorageIterator) Entry() models.StorageEntry {
+	return i.entry
+}
+
+// Err returns the error which stopped the iterator, if any.
+func (i *StorageIterator) Err() error {
+	return i.err
+}
+
+// Close stops the iterator, no further pages are fetched. findstorage holds no state on
+// the node between pages, so there is no session to release.
+func (i *StorageIterator) Close() error {
+	i.done = true
+	i.page = nil
+
+	return nil
+}
+
+// fetch fetches the next page of entries from the node.
+func (i *StorageIterator) fetch() error {
+	requestBodyParams := []interface{}{
+		i.scriptHash, base64.StdEncoding.EncodeToString([]byte(i.prefix)), i.next,
+	}
+	var resp response.FoundStorage
+
+	err := i.client.executeRequest("findstorage", requestBodyParams, &resp)
+	if rpcErr, ok := err.(RPCError); ok && rpcErr.Code == rpcErrorCodeMethodNotFound {
+		return ErrStorageIterationUnavailable
+	} else if err != nil {
+		return err
+	}
+
+	i.page = resp.Result.Results
+	i.next = resp.Result.Next
+	i.done = !resp.Result.Truncated
+
+	return nil
+}
+
+func (i *StorageIterator) fail(err error) {
+	i.err = err
+	i.done = true
+	i.page = nil
+}
@@ -0,0 +1,127 @@
+package neo_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageIterator(t *testing.T) {
+	neo3Version := testRawResult(`{"tcpport": 10333, "useragent": "/Neo:3.0.3/"}`)
+	contract := "0x50ac1c37690cc2cfc594472833cf57505d5f46de"
+
+	// findStorageHandler serves entries "map1" to "map5", two at a time
+	findStorageHandler := func(params []json.RawMessage) (interface{}, *testRPCError) {
+		var start int
+		_ = json.Unmarshal(params[2], &start)
+
+		var results []models.StorageEntry
+		for i := start; i < start+2 && i < 5; i++ {
+			results = append(results, models.StorageEntry{
+				Key:   []byte(fmt.Sprintf("map%d", i+1)),
+				Value: []byte{byte(i + 1)},
+			})
+		}
+
+		return models.FoundStorage{
+			Truncated: start+2 < 5,
+			Next:      start + 2,
+			Results:   results,
+		}, nil
+	}
+
+	t.Run(".IterateStorage()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":  neo3Version,
+				"findstorage": findStorageHandler,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			iterator := client.IterateStorage(contract, "map")
+			defer iterator.Close()
+
+			var keys []string
+			for iterator.Next() {
+				entry := iterator.Entry()
+				keys = append(keys, string(entry.Key))
+				assert.Equal(t, []byte{byte(len(keys))}, entry.Value)
+			}
+
+			assert.NoError(t, iterator.Err())
+			assert.Equal(t, []string{"map1", "map2", "map3", "map4", "map5"}, keys)
+
+			calls := node.Calls("findstorage")
+			assert.Len(t, calls, 3)
+			assert.JSONEq(t, `"`+contract+`"`, string(calls[0].Params[0]))
+			assert.JSONEq(t, `"`+base64.StdEncoding.EncodeToString([]byte("map"))+`"`, string(calls[0].Params[1]))
+			assert.Equal(t, "0", string(calls[0].Params[2]))
+			assert.Equal(t, "4", string(calls[2].Params[2]))
+		})
+
+		t.Run("Close", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":  neo3Version,
+				"findstorage": findStorageHandler,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			iterator := client.IterateStorage(contract, "map")
+			assert.True(t, iterator.Next())
+			assert.NoError(t, iterator.Close())
+			assert.False(t, iterator.Next())
+			assert.Len(t, node.Calls("findstorage"), 1)
+		})
+
+		t.Run("NoEntries", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":  neo3Version,
+				"findstorage": testRawResult(`{"truncated": false, "next": 0, "results": []}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			iterator := client.IterateStorage(contract, "map")
+			assert.False(t, iterator.Next())
+			assert.NoError(t, iterator.Err())
+		})
+
+		t.Run("NEO2Node", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion":  testRawResult(`{"port": 10333, "useragent": "/NEO:2.10.3/"}`),
+				"findstorage": findStorageHandler,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			iterator := client.IterateStorage(contract, "map")
+			assert.False(t, iterator.Next())
+			assert.Equal(t, neo.ErrUnsupportedNetworkGeneration, iterator.Err())
+			assert.Empty(t, node.Calls("findstorage"))
+		})
+
+		t.Run("Unavailable", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getversion": neo3Version,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			iterator := client.IterateStorage(contract, "map")
+			assert.False(t, iterator.Next())
+			assert.Equal(t, neo.ErrStorageIterationUnavailable, iterator.Err())
+		})
+	})
+}
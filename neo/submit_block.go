@@ -0,0 +1,37 @@
+package neo
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models/response"
+)
+
+// ErrBlockRejected is returned by SubmitBlock when the node rejects the block without
+// giving a reason.
+var ErrBlockRejected = errors.New("block was rejected by the NEO node")
+
+// SubmitBlock relays the hex encoded, serialized, block to the network through the node,
+// for tools which assemble blocks themselves, and returns whether the node accepted it.
+// When the node rejects the block the error holds its reason, such as an RPCError for a
+// block which already exists or fails verification, or is ErrBlockRejected when the node
+// does not give one.
+func (c Client) SubmitBlock(hexBlock string) (bool, error) {
+	requestBodyParams := []interface{}{
+		hexBlock,
+	}
+	var resp response.SubmitBlock
+
+	err := c.executeRequest("submitblock", requestBodyParams, &resp)
+	if err != nil {
+		return false, err
+	}
+
+	var accepted bool
+	if err := json.Unmarshal(resp.Result, &accepted); err == nil && !accepted {
+		return false, ErrBlockRejected
+	}
+
+	// NEO3 nodes return the hash of an accepted block rather than true
+	return true, nil
+}
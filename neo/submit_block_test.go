@@ -0,0 +1,73 @@
+package neo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmitBlock(t *testing.T) {
+	hexBlock := "00000000bf4421c88776c53b43ce1dc45463bfd2028e322fdfb60064be150ed3e36125d4"
+
+	t.Run(".SubmitBlock()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"submitblock": testResult(true),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			accepted, err := client.SubmitBlock(hexBlock)
+			assert.NoError(t, err)
+			assert.True(t, accepted)
+
+			calls := node.Calls("submitblock")
+			assert.Len(t, calls, 1)
+			assert.Equal(t, `"`+hexBlock+`"`, string(calls[0].Params[0]))
+		})
+
+		t.Run("NEO3Node", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"submitblock": testResult(map[string]string{"hash": testChainHash(1)}),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			accepted, err := client.SubmitBlock(hexBlock)
+			assert.NoError(t, err)
+			assert.True(t, accepted)
+		})
+
+		t.Run("Rejected", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"submitblock": testResult(false),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			accepted, err := client.SubmitBlock(hexBlock)
+			assert.False(t, accepted)
+			assert.Equal(t, neo.ErrBlockRejected, err)
+		})
+
+		t.Run("ErrorMessage", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"submitblock": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -501, Message: "Block or transaction already exists and cannot be sent repeatedly."}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			accepted, err := client.SubmitBlock(hexBlock)
+			assert.False(t, accepted)
+			assert.Equal(t, neo.RPCError{Code: -501, Message: "Block or transaction already exists and cannot be sent repeatedly."}, err)
+		})
+	})
+}
@@ -0,0 +1,102 @@
+package neo
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+// maxTokenDecimals is the most decimals a NEP-5 or NEP-17 token can have, its decimals
+// are a byte.
+const maxTokenDecimals = 255
+
+// CirculatingSupply returns the circulating supply of the NEP-5 (or NEP-17) token: its
+// total supply less the balances of the excluded addresses, such as team, treasury or
+// locked addresses. Each address is only subtracted once. The supply is returned as a
+// decimal string scaled by the token's decimals, such as "99000000.5", calculated
+// exactly.
+func (c Client) CirculatingSupply(contractHash string, excluded []string) (string, error) {
+	supply, err := c.invokeTokenInteger(contractHash, "totalSupply", nil)
+	if err != nil {
+		return "", err
+	}
+
+	decimals, err := c.invokeTokenInteger(contractHash, "decimals", nil)
+	if err != nil {
+		return "", err
+	}
+
+	if decimals.Sign() < 0 || decimals.Cmp(big.NewInt(maxTokenDecimals)) > 0 {
+		return "", fmt.Errorf("token %s has invalid decimals: %s", contractHash, decimals)
+	}
+
+	for _, address := range uniqueStrings(excluded) {
+		_, scriptHash, err := decodeAddress(address)
+		if err != nil {
+			return "", err
+		}
+
+		balance, err := c.invokeTokenInteger(contractHash, "balanceOf", []models.Parameter{
+			{
+				Type:  models.ParameterTypeHash160,
+				Value: "0x" + hex.EncodeToString(reverseBytes(scriptHash)),
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+
+		supply.Sub(supply, balance)
+	}
+
+	if supply.Sign() < 0 {
+		return "", fmt.Errorf("balances of the excluded addresses exceed the total supply of %s", contractHash)
+	}
+
+	return formatTokenAmount(supply, int(decimals.Int64())), nil
+}
+
+// invokeTokenInteger test invokes the operation of the token contract, which returns an
+// integer.
+func (c Client) invokeTokenInteger(contractHash, operation string, parameters []models.Parameter) (*big.Int, error) {
+	result, err := c.InvokeFunction(contractHash, operation, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.Contains(result.State, "FAULT") || len(result.Stack) == 0 {
+		return nil, fmt.Errorf("token contract %s failed: %s", operation, result.Exception)
+	}
+
+	return result.Stack[0].AsInteger()
+}
+
+// formatTokenAmount returns the raw token amount as a decimal string, with the decimal
+// point decimals digits from the right and without trailing zeros.
+func formatTokenAmount(amount *big.Int, decimals int) string {
+	sign := ""
+	if amount.Sign() < 0 {
+		sign = "-"
+	}
+
+	digits := new(big.Int).Abs(amount).String()
+	if decimals == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+
+	whole := digits[:len(digits)-decimals]
+	fraction := strings.TrimRight(digits[len(digits)-decimals:], "0")
+
+	if fraction == "" {
+		return sign + whole
+	}
+
+	return sign + whole + "." + fraction
+}
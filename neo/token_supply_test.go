@@ -0,0 +1,101 @@
+package neo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenSupply(t *testing.T) {
+	contract := "0xecc6b20d3ccac1ee9ef109af5a7cdb85706b1df9"
+
+	newTokenNode := func(totalSupply, decimals string, balances map[string]string) *testNode {
+		return newTestNode(map[string]testHandler{
+			"invokefunction": func(params []json.RawMessage) (interface{}, *testRPCError) {
+				var operation string
+				_ = json.Unmarshal(params[1], &operation)
+
+				stackItem := models.StackItem{Type: "Integer"}
+
+				switch operation {
+				case "totalSupply":
+					stackItem.Value = json.RawMessage(`"` + totalSupply + `"`)
+				case "decimals":
+					stackItem.Value = json.RawMessage(`"` + decimals + `"`)
+				case "balanceOf":
+					var parameters []models.Parameter
+					_ = json.Unmarshal(params[2], &parameters)
+
+					// balances are returned as little-endian byte arrays, as by NEO2 tokens
+					stackItem = models.StackItem{
+						Type:  "ByteArray",
+						Value: json.RawMessage(`"` + balances[parameters[0].Value.(string)] + `"`),
+					}
+				}
+
+				return map[string]interface{}{
+					"state": "HALT",
+					"stack": []models.StackItem{stackItem},
+				}, nil
+			},
+		})
+	}
+
+	t.Run(".CirculatingSupply()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			// a supply of 1000000000000 tokens, of which 0.5 are excluded
+			node := newTokenNode("100000000000000000000", "8", map[string]string{
+				"0xcef0c0fdcfe7838eff6ff104f9cdec2922297537": "80f0fa02",
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			excluded := []string{testAccounts[0].publicAddress, testAccounts[0].publicAddress}
+
+			supply, err := client.CirculatingSupply(contract, excluded)
+			assert.NoError(t, err)
+			assert.Equal(t, "999999999999.5", supply)
+			assert.Len(t, node.Calls("invokefunction"), 3)
+		})
+
+		t.Run("NoExcludedAddresses", func(t *testing.T) {
+			node := newTokenNode("100000000000000000001", "8", nil)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			supply, err := client.CirculatingSupply(contract, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, "1000000000000.00000001", supply)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTokenNode("100", "8", map[string]string{
+				"0xcef0c0fdcfe7838eff6ff104f9cdec2922297537": "80f0fa02",
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.CirculatingSupply(contract, []string{testAccounts[0].publicAddress})
+			assert.Error(t, err)
+
+			_, err = client.CirculatingSupply(contract, []string{"not an address"})
+			assert.Error(t, err)
+		})
+
+		t.Run("InvalidDecimals", func(t *testing.T) {
+			node := newTokenNode("100", "256", nil)
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.CirculatingSupply(contract, nil)
+			assert.EqualError(t, err, "token "+contract+" has invalid decimals: 256")
+		})
+	})
+}
@@ -0,0 +1,80 @@
+package neo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+)
+
+// ErrTransactionUnconfirmed is returned by GetBlockForTransaction when the transaction is
+// still waiting in the mempool, so it is not in a block yet.
+var ErrTransactionUnconfirmed = errors.New("transaction is not confirmed")
+
+// GetBlockForTransaction returns the block which contains the transaction, using the
+// block hash of the transaction. ErrTransactionUnconfirmed is returned when the
+// transaction is not in a block yet.
+func (c Client) GetBlockForTransaction(txHash string) (*models.Block, error) {
+	transaction, err := c.GetTransaction(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := transactionBlockHash(transaction)
+	if blockHash == "" {
+		return nil, ErrTransactionUnconfirmed
+	}
+
+	return c.GetBlockByHash(blockHash)
+}
+
+// fillBlockDetails sets the Confirmations and BlockTime of a confirmed transaction from
+// the header of its block, for nodes which do not include them in the transaction. When
+// they cannot be fetched the transaction is left unchanged.
+func (c Client) fillBlockDetails(transaction *models.Transaction) error {
+	blockHash := transactionBlockHash(transaction)
+	if blockHash == "" || (transaction.Confirmations > 0 && transaction.BlockTime > 0) {
+		return nil
+	}
+
+	header, err := c.GetBlockHeaderByHash(blockHash)
+	if err != nil {
+		return err
+	}
+
+	confirmations := header.Confirmations
+	if transaction.Confirmations == 0 && confirmations == 0 {
+		blockCount, err := c.GetBlockCount()
+		if err != nil {
+			return err
+		}
+
+		confirmations = blockCount - header.Index
+	}
+
+	// the details are only set once all of them are known
+	if transaction.BlockTime == 0 {
+		transaction.BlockTime = int(header.Time)
+	}
+
+	if transaction.Confirmations == 0 {
+		transaction.Confirmations = int(confirmations)
+	}
+
+	return nil
+}
+
+// transactionBlockHash returns the 0x prefixed hash of the block containing the
+// transaction, or an empty string when it is not in a block yet.
+func transactionBlockHash(transaction *models.Transaction) string {
+	blockHash := strings.ToLower(strings.TrimSpace(transaction.BlockHash))
+	if blockHash == "" {
+		return ""
+	}
+
+	if !strings.HasPrefix(blockHash, "0x") {
+		blockHash = "0x" + blockHash
+	}
+
+	return blockHash
+}
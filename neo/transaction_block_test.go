@@ -0,0 +1,81 @@
+package neo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionBlock(t *testing.T) {
+	txHash := "0xfb5bd72b2d6792d75dc2f1084ffa9e9f70ca85543c717a6b13d9959b452a57d6"
+	blockHash := "0x7c5b4c8a70336bf68e8679be7c9a2a15f85c0f6d0e14389019dcc3edfab2bb4b"
+
+	newNode := func(transaction map[string]interface{}) *testNode {
+		return newTestNode(map[string]testHandler{
+			"getrawtransaction": testResult(transaction),
+			"getblock": func(params []json.RawMessage) (interface{}, *testRPCError) {
+				var hash string
+				_ = json.Unmarshal(params[0], &hash)
+
+				if hash != blockHash {
+					return nil, &testRPCError{Code: -100, Message: "Unknown block"}
+				}
+
+				return map[string]interface{}{
+					"hash":  blockHash,
+					"index": 2000,
+				}, nil
+			},
+		})
+	}
+
+	t.Run(".GetBlockForTransaction()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newNode(map[string]interface{}{
+				"txid":          txHash,
+				"blockhash":     blockHash,
+				"confirmations": 10,
+				"blocktime":     1506871433,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			block, err := client.GetBlockForTransaction(txHash)
+			assert.NoError(t, err)
+			assert.Equal(t, blockHash, block.Hash)
+			assert.Equal(t, int64(2000), block.Index)
+		})
+
+		t.Run("UnprefixedBlockHash", func(t *testing.T) {
+			node := newNode(map[string]interface{}{
+				"txid":          txHash,
+				"blockhash":     blockHash[2:],
+				"confirmations": 10,
+				"blocktime":     1506871433,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			block, err := client.GetBlockForTransaction(txHash)
+			assert.NoError(t, err)
+			assert.Equal(t, blockHash, block.Hash)
+		})
+
+		t.Run("Unconfirmed", func(t *testing.T) {
+			node := newNode(map[string]interface{}{
+				"txid": txHash,
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetBlockForTransaction(txHash)
+			assert.Equal(t, neo.ErrTransactionUnconfirmed, err)
+			assert.Empty(t, node.Calls("getblock"))
+		})
+	})
+}
@@ -0,0 +1,49 @@
+package neo
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+// NormalizeTxHashes prepares a list of transaction hashes for bulk fetching. Each hash is
+// trimmed of whitespace and normalized to a lowercase, 0x prefixed, 32-byte hex string,
+// the form returned by the node, and duplicates are removed. Hashes which are not 32
+// bytes of hex are returned, as given, in invalid. Both lists keep the order of the
+// input.
+func NormalizeTxHashes(hashes []string) (valid []string, invalid []string) {
+	seen := make(map[string]bool, len(hashes))
+
+	for _, hash := range hashes {
+		normalized, ok := normalizeTxHash(hash)
+		if !ok {
+			invalid = append(invalid, hash)
+			continue
+		}
+
+		if seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+
+		valid = append(valid, normalized)
+	}
+
+	return valid, invalid
+}
+
+// normalizeTxHash returns the lowercase, 0x prefixed, form of the transaction hash, and
+// whether it is 32 bytes of hex.
+func normalizeTxHash(hash string) (string, bool) {
+	hash = strings.ToLower(strings.TrimSpace(hash))
+	hash = strings.TrimPrefix(hash, "0x")
+
+	if len(hash) != 64 {
+		return "", false
+	}
+
+	if _, err := hex.DecodeString(hash); err != nil {
+		return "", false
+	}
+
+	return "0x" + hash, true
+}
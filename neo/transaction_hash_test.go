@@ -0,0 +1,53 @@
+package neo_test
+
+import (
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionHash(t *testing.T) {
+	t.Run("NormalizeTxHashes()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			valid, invalid := neo.NormalizeTxHashes([]string{
+				"0xfb5bd72b2d6792d75dc2f1084ffa9e9f70ca85543c717a6b13d9959b452a57d6",
+				"2d33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c74b",
+			})
+			assert.Equal(t, []string{
+				"0xfb5bd72b2d6792d75dc2f1084ffa9e9f70ca85543c717a6b13d9959b452a57d6",
+				"0x2d33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c74b",
+			}, valid)
+			assert.Empty(t, invalid)
+		})
+
+		t.Run("MixedInput", func(t *testing.T) {
+			valid, invalid := neo.NormalizeTxHashes([]string{
+				" 0xFB5BD72B2D6792D75DC2F1084FFA9E9F70CA85543C717A6B13D9959B452A57D6\n",
+				"",
+				"0x2d33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c74b",
+				"fb5bd72b2d6792d75dc2f1084ffa9e9f70ca85543c717a6b13d9959b452a57d6",
+				"0x2d33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c7",
+				"0xzz33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c74b",
+				"0x2d33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c74b",
+				"0x0x2d33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c74b",
+			})
+			assert.Equal(t, []string{
+				"0xfb5bd72b2d6792d75dc2f1084ffa9e9f70ca85543c717a6b13d9959b452a57d6",
+				"0x2d33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c74b",
+			}, valid)
+			assert.Equal(t, []string{
+				"",
+				"0x2d33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c7",
+				"0xzz33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c74b",
+				"0x0x2d33fd0ab2bf0a2e3c07b3a2a3ab2a73b33a07e2ac91ba2c4d03d38d21e4c74b",
+			}, invalid)
+		})
+
+		t.Run("EmptyInput", func(t *testing.T) {
+			valid, invalid := neo.NormalizeTxHashes(nil)
+			assert.Empty(t, valid)
+			assert.Empty(t, invalid)
+		})
+	})
+}
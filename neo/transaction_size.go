@@ -0,0 +1,51 @@
+package neo
+
+const (
+	// transactionInputSize is the size of an input: the hash of the previous transaction
+	// and the index of its output.
+	transactionInputSize = 32 + 2
+
+	// transactionOutputSize is the size of an output: the asset ID, the Fixed8 value and
+	// the script hash of the address.
+	transactionOutputSize = 32 + 8 + 20
+
+	// transactionWitnessSize is the size of the witness of a single signature address:
+	// the invocation script (PUSHBYTES64 and the signature) and the verification script
+	// (PUSHBYTES33, the compressed public key and CHECKSIG), each prefixed by its length.
+	transactionWitnessSize = (1 + 1 + 64) + (1 + 1 + 33 + 1)
+)
+
+// EstimateTransactionSize returns the size, in bytes, of a serialized NEO2
+// ContractTransaction with the given number of inputs, outputs and signatures, which can
+// be used to work out its network fee before it is built. The estimate assumes that:
+//
+//   - the transaction has no attributes, each attribute adds the size of its data plus 2
+//     or more bytes.
+//   - each signature is the witness of a single signature address. Multi-signature and
+//     contract witnesses are larger.
+//
+// Under those assumptions the estimate is exact.
+func EstimateTransactionSize(inputCount, outputCount, signatureCount int) int {
+	// type and version, followed by no attributes
+	size := 1 + 1 + varUintSize(0)
+
+	size += varUintSize(inputCount) + inputCount*transactionInputSize
+	size += varUintSize(outputCount) + outputCount*transactionOutputSize
+	size += varUintSize(signatureCount) + signatureCount*transactionWitnessSize
+
+	return size
+}
+
+// varUintSize returns the size of the value encoded as a variable length integer.
+func varUintSize(value int) int {
+	switch {
+	case value < 0xFD:
+		return 1
+	case value <= 0xFFFF:
+		return 3
+	case value <= 0xFFFFFFFF:
+		return 5
+	}
+
+	return 9
+}
@@ -0,0 +1,74 @@
+package neo_test
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+// contractTransactionHex serializes a ContractTransaction with the given number of
+// inputs, outputs and single signature witnesses.
+func contractTransactionHex(inputCount, outputCount, signatureCount int) string {
+	varUint := func(value int) string {
+		if value < 0xFD {
+			return hex.EncodeToString([]byte{byte(value)})
+		}
+
+		return hex.EncodeToString([]byte{0xFD, byte(value), byte(value >> 8)})
+	}
+
+	var builder strings.Builder
+	builder.WriteString("8000" + varUint(0))
+
+	builder.WriteString(varUint(inputCount))
+	for i := 0; i < inputCount; i++ {
+		builder.WriteString(strings.TrimPrefix(testTransactions[0].hash, "0x") + "0000")
+	}
+
+	builder.WriteString(varUint(outputCount))
+	for i := 0; i < outputCount; i++ {
+		builder.WriteString(strings.TrimPrefix(testTransactionOutputs[0].asset, "0x"))
+		builder.WriteString("00e1f50500000000")
+		builder.WriteString(testAccounts[0].signature)
+	}
+
+	builder.WriteString(varUint(signatureCount))
+	for i := 0; i < signatureCount; i++ {
+		builder.WriteString("4140" + strings.Repeat("ab", 64))
+		builder.WriteString("2321" + testAccounts[i%len(testAccounts)].publicKey + "ac")
+	}
+
+	return builder.String()
+}
+
+func TestTransactionSize(t *testing.T) {
+	t.Run("EstimateTransactionSize()", func(t *testing.T) {
+		node := newTestNode(map[string]testHandler{
+			"sendrawtransaction": testResult(true),
+		})
+		defer node.Close()
+
+		client := neo.NewClient(node.URL)
+
+		for _, shape := range [][3]int{
+			{1, 1, 1},
+			{1, 2, 1},
+			{3, 2, 2},
+			{0, 0, 0},
+			{300, 1, 1},
+		} {
+			rawTransaction := contractTransactionHex(shape[0], shape[1], shape[2])
+
+			// the serialization is checked by decoding it
+			_, err := client.SendRawTransactions(context.Background(), []string{rawTransaction}, false)
+			assert.NoError(t, err)
+
+			size := neo.EstimateTransactionSize(shape[0], shape[1], shape[2])
+			assert.Equal(t, len(rawTransaction)/2, size, "%v", shape)
+		}
+	})
+}
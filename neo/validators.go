@@ -0,0 +1,92 @@
+package neo
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/lomocoin/neo-go-sdk/neo/models/response"
+)
+
+type (
+	// validatorCache holds the validators last fetched by ValidatorSet. It is shared by
+	// copies of the Client which created it, so all access is guarded by the mutex.
+	validatorCache struct {
+		mutex      sync.Mutex
+		validators []models.Validator
+		fetchedAt  time.Time
+	}
+)
+
+// ErrCandidateNotFound is returned by GetCandidateVotes when the public key is not one of
+// the node's validator candidates.
+var ErrCandidateNotFound = errors.New("public key is not a validator candidate")
+
+// GetCandidateVotes returns the number of votes for the validator candidate with the given
+// public key (hex encoded, compressed). The candidates are read with getvalidators, or
+// getcandidates on nodes which do not have it.
+func (c Client) GetCandidateVotes(pubKey string) (string, error) {
+	validators, err := c.GetValidators()
+	if err != nil {
+		return "", err
+	}
+
+	for _, validator := range validators {
+		if strings.EqualFold(validator.PublicKey, pubKey) {
+			return validator.Votes, nil
+		}
+	}
+
+	return "", ErrCandidateNotFound
+}
+
+// ValidatorSet returns the validators, like GetValidators, but reuses the validators
+// fetched by an earlier call when they are no older than maxAge, as the validators
+// change slowly. It is intended for dashboards which refresh often. Concurrent calls wait
+// for a single refresh rather than each calling the node.
+//
+// The validators of the next block are fetched with getnextblockvalidators (NEO3), which
+// are all returned as active. Nodes which do not have it are asked for getvalidators
+// (NEO2), and then getcandidates, as GetValidators does, which return every candidate.
+func (c Client) ValidatorSet(maxAge time.Duration) ([]models.Validator, error) {
+	if c.validators == nil {
+		return c.getValidatorSet()
+	}
+
+	c.validators.mutex.Lock()
+	defer c.validators.mutex.Unlock()
+
+	if c.validators.validators == nil || time.Since(c.validators.fetchedAt) > maxAge {
+		validators, err := c.getValidatorSet()
+		if err != nil {
+			return nil, err
+		}
+
+		c.validators.validators = validators
+		c.validators.fetchedAt = time.Now()
+	}
+
+	return append([]models.Validator{}, c.validators.validators...), nil
+}
+
+// getValidatorSet fetches the validators with getnextblockvalidators, falling back to
+// GetValidators when the node does not have it.
+func (c Client) getValidatorSet() ([]models.Validator, error) {
+	var resp response.Validators
+
+	err := c.executeRequest("getnextblockvalidators", nil, &resp)
+	if rpcErr, ok := err.(RPCError); ok && rpcErr.Code == rpcErrorCodeMethodNotFound {
+		return c.GetValidators()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resp.Result {
+		resp.Result[i].Active = true
+	}
+
+	return resp.Result, nil
+}
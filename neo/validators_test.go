@@ -0,0 +1,191 @@
+package neo_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidators(t *testing.T) {
+	validatorsJSON := `[
+		{
+			"publickey": "02486fd15702c4490a26703112a5cc1d0923fd697a33406bd5a1c00e0013b09a70",
+			"votes": "46632420",
+			"active": true
+		},
+		{
+			"publickey": "024c7b7fb6c310fccf1ba33b082519d82964ea93868d676662d4a59ad548df0e7d",
+			"votes": "0",
+			"active": false
+		}
+	]`
+
+	t.Run(".GetValidators()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getvalidators": testRawResult(validatorsJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			validators, err := client.GetValidators()
+			assert.NoError(t, err)
+			assert.Equal(t, []models.Validator{
+				{
+					PublicKey: "02486fd15702c4490a26703112a5cc1d0923fd697a33406bd5a1c00e0013b09a70",
+					Votes:     "46632420",
+					Active:    true,
+				},
+				{
+					PublicKey: "024c7b7fb6c310fccf1ba33b082519d82964ea93868d676662d4a59ad548df0e7d",
+					Votes:     "0",
+				},
+			}, validators)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetValidators()
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run(".GetCandidateVotes()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getvalidators": testRawResult(validatorsJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			votes, err := client.GetCandidateVotes(
+				"02486FD15702C4490A26703112A5CC1D0923FD697A33406BD5A1C00E0013B09A70",
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, "46632420", votes)
+		})
+
+		t.Run("GetCandidatesFallback", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getcandidates": testRawResult(validatorsJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			votes, err := client.GetCandidateVotes(
+				"024c7b7fb6c310fccf1ba33b082519d82964ea93868d676662d4a59ad548df0e7d",
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, "0", votes)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getvalidators": testRawResult(validatorsJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetCandidateVotes(testAccounts[0].publicKey)
+			assert.Equal(t, neo.ErrCandidateNotFound, err)
+		})
+	})
+	t.Run(".ValidatorSet()", func(t *testing.T) {
+		nextBlockValidatorsJSON := `[
+			{
+				"publickey": "02486fd15702c4490a26703112a5cc1d0923fd697a33406bd5a1c00e0013b09a70",
+				"votes": "46632420"
+			}
+		]`
+
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getnextblockvalidators": testRawResult(nextBlockValidatorsJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					validators, err := client.ValidatorSet(time.Minute)
+					assert.NoError(t, err)
+					assert.Equal(t, []models.Validator{
+						{
+							PublicKey: "02486fd15702c4490a26703112a5cc1d0923fd697a33406bd5a1c00e0013b09a70",
+							Votes:     "46632420",
+							Active:    true,
+						},
+					}, validators)
+				}()
+			}
+			wg.Wait()
+
+			assert.Len(t, node.Calls("getnextblockvalidators"), 1)
+		})
+
+		t.Run("Stale", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getnextblockvalidators": testRawResult(nextBlockValidatorsJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			for i := 0; i < 2; i++ {
+				_, err := client.ValidatorSet(0)
+				assert.NoError(t, err)
+			}
+
+			assert.Len(t, node.Calls("getnextblockvalidators"), 2)
+		})
+
+		t.Run("GetValidatorsFallback", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getvalidators": testRawResult(validatorsJSON),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			validators, err := client.ValidatorSet(time.Minute)
+			assert.NoError(t, err)
+			assert.Len(t, validators, 2)
+			assert.False(t, validators[1].Active)
+
+			_, err = client.ValidatorSet(time.Minute)
+			assert.NoError(t, err)
+			assert.Len(t, node.Calls("getvalidators"), 1)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			for i := 0; i < 2; i++ {
+				_, err := client.ValidatorSet(time.Minute)
+				assert.Error(t, err)
+			}
+
+			assert.Len(t, node.Calls("getnextblockvalidators"), 2)
+		})
+	})
+}
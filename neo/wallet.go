@@ -0,0 +1,228 @@
+package neo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/lomocoin/neo-go-sdk/neo/models/response"
+	"github.com/pkg/errors"
+)
+
+type (
+	// walletCapability caches whether the node exposes wallet methods. It is shared by
+	// copies of the Client which created it, so all access is guarded by the mutex.
+	walletCapability struct {
+		mutex     sync.Mutex
+		checked   bool
+		available bool
+	}
+)
+
+const (
+	rpcErrorCodeAccessDenied   = -400
+	rpcErrorCodeMethodNotFound = -32601
+)
+
+// ErrWalletMethodsUnavailable is returned by wallet methods (DumpPrivKey, GetBalance,
+// GetNewAddress, GetWalletHeight, ImportPrivKey, ListAddress, SendFrom, SendToAddress,
+// SendMany) when the Client was created with WithWalletMethodCheck, and the node does not
+// expose wallet methods, as is the case for public nodes.
+var ErrWalletMethodsUnavailable = errors.New("node does not expose wallet methods")
+
+// HasOpenWallet reports whether the node has a wallet open, which is required by methods
+// such as GetBalance, GetNewAddress and SendToAddress.
+//
+// The node is probed with getwalletheight, which has no side effects. A result means a
+// wallet is open. An "access denied" error (code -400) means no wallet is open, and a
+// "method not found" error (code -32601) means the node does not expose wallet methods
+// at all, in both cases false is returned without an error. Any other error is returned
+// as is. The result is not cached, as a wallet can be opened or closed at any time.
+func (c Client) HasOpenWallet() (bool, error) {
+	var resp response.Integer
+
+	err := c.executeRequest("getwalletheight", nil, &resp)
+	if err == nil {
+		return true, nil
+	}
+
+	if rpcErr, ok := err.(RPCError); ok {
+		switch rpcErr.Code {
+		case rpcErrorCodeAccessDenied, rpcErrorCodeMethodNotFound:
+			return false, nil
+		}
+	}
+
+	return false, err
+}
+
+// ListAddress returns the addresses of the wallet open on the node. The node must have a
+// wallet open, otherwise its "access denied" error (code -400) is returned, as with
+// GetBalance and GetNewAddress.
+func (c Client) ListAddress() ([]models.WalletAddress, error) {
+	if err := c.checkWalletMethods(); err != nil {
+		return nil, err
+	}
+
+	var resp response.WalletAddresses
+
+	err := c.executeRequest("listaddress", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Result, nil
+}
+
+// GetWalletHeight returns the height up to which the wallet open on the node has been
+// synchronized, which trails the block count while the wallet is being rescanned. The
+// node must have a wallet open, otherwise its "access denied" error (code -400) is
+// returned, as with GetBalance.
+func (c Client) GetWalletHeight() (int64, error) {
+	if err := c.checkWalletMethods(); err != nil {
+		return 0, err
+	}
+
+	var resp response.Integer
+
+	err := c.executeRequest("getwalletheight", nil, &resp)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Result, nil
+}
+
+// DumpPrivKey returns the private key of the address, in WIF, from the wallet open on the
+// node, such as for backing up an address created with GetNewAddress. The address is
+// checked locally before the node is called. The node must have a wallet open which
+// holds the key of the address, otherwise the node's error is returned.
+func (c Client) DumpPrivKey(address string) (string, error) {
+	if _, _, err := decodeAddress(address); err != nil {
+		return "", err
+	}
+
+	if err := c.checkWalletMethods(); err != nil {
+		return "", err
+	}
+
+	requestBodyParams := []interface{}{
+		address,
+	}
+	var resp response.String
+
+	err := c.executeRequest("dumpprivkey", requestBodyParams, &resp)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Result, nil
+}
+
+// ImportPrivKey imports the private key, in WIF, into the wallet open on the node, such as
+// for loading funding keys at startup, and returns the address of the key in the wallet.
+// The WIF is checked locally before the node is called. The node must have a wallet open,
+// otherwise its "access denied" error (code -400) is returned.
+func (c Client) ImportPrivKey(wif string) (*models.WalletAddress, error) {
+	if _, err := NewPrivateKeyFromWIF(wif); err != nil {
+		return nil, err
+	}
+
+	if err := c.checkWalletMethods(); err != nil {
+		return nil, err
+	}
+
+	requestBodyParams := []interface{}{
+		wif,
+	}
+	var resp response.WalletAddress
+
+	err := c.executeRequest("importprivkey", requestBodyParams, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// WaitForAddress polls ListAddress every interval until the address is in the wallet open
+// on the node, for when an address which was just created or imported is not yet
+// listed, as with nodes behind a load balancer. The node must have a wallet open. When
+// ctx is done first, an error wrapping ctx.Err() is returned. Errors of ListAddress are
+// returned straight away. interval must be greater than 0.
+func (c Client) WaitForAddress(ctx context.Context, address string, interval time.Duration) error {
+	if interval <= 0 {
+		return errors.Errorf("poll interval must be greater than 0, got: %s", interval)
+	}
+
+	c = c.WithContext(ctx)
+
+	for {
+		addresses, err := c.ListAddress()
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return errors.Wrapf(ctxErr, "address %s did not appear in the wallet", address)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, walletAddress := range addresses {
+			if walletAddress.Address == address {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "address %s did not appear in the wallet", address)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// checkWalletMethods returns ErrWalletMethodsUnavailable if the wallet method check is
+// enabled and the node does not expose wallet methods.
+//
+// Wallet methods are built into the node rather than provided by a plugin, so listplugins
+// and getversion do not reveal them. Instead getwalletheight is called once, a "method
+// not found" error (code -32601) means wallet methods are unavailable, while a result or
+// an "access denied" error (code -400, no wallet open) means they are available. The
+// outcome is cached, other errors are returned and the check is tried again next time.
+func (c Client) checkWalletMethods() error {
+	if c.walletCapability == nil {
+		return nil
+	}
+
+	c.walletCapability.mutex.Lock()
+	defer c.walletCapability.mutex.Unlock()
+
+	if !c.walletCapability.checked {
+		var resp response.Integer
+
+		err := c.executeRequest("getwalletheight", nil, &resp)
+		if rpcErr, ok := err.(RPCError); ok {
+			switch rpcErr.Code {
+			case rpcErrorCodeMethodNotFound:
+				err = nil
+			case rpcErrorCodeAccessDenied:
+				err = nil
+				c.walletCapability.available = true
+			}
+		} else if err == nil {
+			c.walletCapability.available = true
+		}
+
+		if err != nil {
+			return err
+		}
+
+		c.walletCapability.checked = true
+	}
+
+	if !c.walletCapability.available {
+		return ErrWalletMethodsUnavailable
+	}
+
+	return nil
+}
@@ -0,0 +1,349 @@
+package neo_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/lomocoin/neo-go-sdk/neo/models"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWallet(t *testing.T) {
+	t.Run(".HasOpenWallet()", func(t *testing.T) {
+		testCases := []struct {
+			description string
+			handler     testHandler
+			open        bool
+			err         bool
+		}{
+			{
+				description: "Open",
+				handler:     testResult(1511369),
+				open:        true,
+			},
+			{
+				description: "AccessDenied",
+				handler: func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -400, Message: "Access denied"}
+				},
+			},
+			{
+				description: "MethodNotFound",
+			},
+			{
+				description: "OtherError",
+				handler: func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -100, Message: "Unknown error"}
+				},
+				err: true,
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.description, func(t *testing.T) {
+				handlers := map[string]testHandler{}
+				if testCase.handler != nil {
+					handlers["getwalletheight"] = testCase.handler
+				}
+
+				node := newTestNode(handlers)
+				defer node.Close()
+
+				client := neo.NewClient(node.URL)
+
+				open, err := client.HasOpenWallet()
+				assert.Equal(t, testCase.open, open)
+				if testCase.err {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+			})
+		}
+	})
+
+	t.Run(".ListAddress()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"listaddress": testRawResult(`[
+					{"address": "` + testAccounts[0].publicAddress + `", "haskey": true, "label": null, "watchonly": false},
+					{"address": "` + testAccounts[1].publicAddress + `", "haskey": false, "label": "cold", "watchonly": true}
+				]`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			addresses, err := client.ListAddress()
+			assert.NoError(t, err)
+			assert.Equal(t, []models.WalletAddress{
+				{Address: testAccounts[0].publicAddress, HasKey: true},
+				{Address: testAccounts[1].publicAddress, Label: "cold", WatchOnly: true},
+			}, addresses)
+		})
+
+		t.Run("NoWalletOpen", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"listaddress": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -400, Message: "Access denied"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.ListAddress()
+			assert.Equal(t, neo.RPCError{Code: -400, Message: "Access denied"}, err)
+		})
+	})
+
+	t.Run(".GetWalletHeight()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getwalletheight": testResult(1511369),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			height, err := client.GetWalletHeight()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1511369), height)
+		})
+
+		t.Run("NoWalletOpen", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getwalletheight": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -400, Message: "Access denied"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.GetWalletHeight()
+			assert.Equal(t, neo.RPCError{Code: -400, Message: "Access denied"}, err)
+		})
+	})
+
+	t.Run(".DumpPrivKey()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"dumpprivkey": testResult(testAccounts[0].wif),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			wif, err := client.DumpPrivKey(testAccounts[0].publicAddress)
+			assert.NoError(t, err)
+			assert.Equal(t, testAccounts[0].wif, wif)
+
+			calls := node.Calls("dumpprivkey")
+			assert.Len(t, calls, 1)
+			assert.Equal(t, `"`+testAccounts[0].publicAddress+`"`, string(calls[0].Params[0]))
+		})
+
+		t.Run("InvalidAddress", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.DumpPrivKey("not an address")
+			assert.Error(t, err)
+			assert.Empty(t, node.Calls("dumpprivkey"))
+		})
+
+		t.Run("NotInWallet", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"dumpprivkey": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -2146232969, Message: "The given key was not present in the dictionary."}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.DumpPrivKey(testAccounts[0].publicAddress)
+			assert.Equal(t, neo.RPCError{Code: -2146232969, Message: "The given key was not present in the dictionary."}, err)
+		})
+	})
+
+	t.Run(".ImportPrivKey()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"importprivkey": testRawResult(`{
+					"address": "` + testAccounts[0].publicAddress + `",
+					"haskey": true,
+					"label": null,
+					"watchonly": false
+				}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			address, err := client.ImportPrivKey(testAccounts[0].wif)
+			assert.NoError(t, err)
+			assert.Equal(t, &models.WalletAddress{Address: testAccounts[0].publicAddress, HasKey: true}, address)
+
+			calls := node.Calls("importprivkey")
+			assert.Len(t, calls, 1)
+			assert.Equal(t, `"`+testAccounts[0].wif+`"`, string(calls[0].Params[0]))
+		})
+
+		t.Run("InvalidWIF", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.ImportPrivKey(testAccounts[0].publicAddress)
+			assert.Error(t, err)
+			assert.Empty(t, node.Calls("importprivkey"))
+		})
+
+		t.Run("NoWalletOpen", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"importprivkey": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -400, Message: "Access denied"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.ImportPrivKey(testAccounts[0].wif)
+			assert.Equal(t, neo.RPCError{Code: -400, Message: "Access denied"}, err)
+		})
+	})
+
+	t.Run(".WaitForAddress()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			var calls int32
+
+			node := newTestNode(map[string]testHandler{
+				"listaddress": func([]json.RawMessage) (interface{}, *testRPCError) {
+					addresses := []models.WalletAddress{{Address: testAccounts[0].publicAddress}}
+					if atomic.AddInt32(&calls, 1) >= 3 {
+						addresses = append(addresses, models.WalletAddress{Address: testAccounts[1].publicAddress})
+					}
+
+					return addresses, nil
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			err := client.WaitForAddress(context.Background(), testAccounts[1].publicAddress, time.Millisecond)
+			assert.NoError(t, err)
+			assert.Len(t, node.Calls("listaddress"), 3)
+		})
+
+		t.Run("Timeout", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"listaddress": testResult([]models.WalletAddress{}),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			err := client.WaitForAddress(ctx, testAccounts[1].publicAddress, 10*time.Millisecond)
+			assert.Equal(t, context.DeadlineExceeded, errors.Cause(err))
+			assert.Contains(t, err.Error(), testAccounts[1].publicAddress)
+		})
+
+		t.Run("SadCase", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"listaddress": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -400, Message: "Access denied"}
+				},
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			err := client.WaitForAddress(context.Background(), testAccounts[1].publicAddress, time.Millisecond)
+			assert.Equal(t, neo.RPCError{Code: -400, Message: "Access denied"}, err)
+		})
+
+		t.Run("InvalidInterval", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"listaddress": testResult([]interface{}{}),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			err := client.WaitForAddress(context.Background(), testAccounts[1].publicAddress, 0)
+			assert.Error(t, err)
+			assert.Empty(t, node.Calls("listaddress"))
+		})
+	})
+
+	t.Run("WithWalletMethodCheck()", func(t *testing.T) {
+		asset := "0xc56f33fc6ecfcd0c225c4ab356fee59390af8560be0e930faebe74a6daff7c9b"
+
+		t.Run("Unavailable", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithWalletMethodCheck())
+
+			_, err := client.SendToAddress(asset, testAccounts[0].publicAddress, "1")
+			assert.Equal(t, neo.ErrWalletMethodsUnavailable, err)
+
+			_, err = client.GetNewAddress()
+			assert.Equal(t, neo.ErrWalletMethodsUnavailable, err)
+
+			_, err = client.ListAddress()
+			assert.Equal(t, neo.ErrWalletMethodsUnavailable, err)
+
+			assert.Len(t, node.Calls("getwalletheight"), 1)
+			assert.Empty(t, node.Calls("sendtoaddress"))
+			assert.Empty(t, node.Calls("listaddress"))
+		})
+
+		t.Run("Available", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getwalletheight": func([]json.RawMessage) (interface{}, *testRPCError) {
+					return nil, &testRPCError{Code: -400, Message: "Access denied"}
+				},
+				"sendtoaddress": testRawResult(`{"txid": "0x01"}`),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL, neo.WithWalletMethodCheck())
+
+			for i := 0; i < 2; i++ {
+				txID, err := client.SendToAddress(asset, testAccounts[0].publicAddress, "1")
+				assert.NoError(t, err)
+				assert.Equal(t, "0x01", txID)
+			}
+
+			assert.Len(t, node.Calls("getwalletheight"), 1)
+		})
+
+		t.Run("Disabled", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			_, err := client.SendToAddress(asset, testAccounts[0].publicAddress, "1")
+			assert.Error(t, err)
+			assert.NotEqual(t, neo.ErrWalletMethodsUnavailable, err)
+			assert.Empty(t, node.Calls("getwalletheight"))
+		})
+	})
+}
@@ -0,0 +1,28 @@
+package neo
+
+import "context"
+
+// Warmup calls each of the Client's nodes concurrently with a lightweight getblockcount,
+// so that a connection to each is opened, including any TLS handshake, and pooled for the
+// requests which follow. Nodes which fail do not stop the others from being warmed up, a
+// BatchError is returned, keyed by node URI, once they have all been called. When ctx is
+// done no further nodes are called, those in flight are aborted, and ctx.Err() is
+// returned.
+func (c Client) Warmup(ctx context.Context) error {
+	c = c.WithContext(ctx)
+
+	batchErr := runConcurrently(ctx, c.nodeURIs, len(c.nodeURIs), func(nodeURI string) error {
+		_, err := c.forNode(nodeURI).GetBlockCount()
+		return err
+	})
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if batchErr != nil {
+		return batchErr
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package neo_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lomocoin/neo-go-sdk/neo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarmup(t *testing.T) {
+	t.Run(".Warmup()", func(t *testing.T) {
+		t.Run("HappyCase", func(t *testing.T) {
+			server, connections := newConnectionCountingNode()
+			defer server.Close()
+
+			httpClient := &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()}
+			client := neo.NewClient(server.URL, neo.WithHTTPClient(httpClient))
+
+			err := client.Warmup(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, int32(1), atomic.LoadInt32(connections))
+
+			for i := 0; i < 3; i++ {
+				_, err := client.GetBlockCount()
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, int32(1), atomic.LoadInt32(connections))
+		})
+
+		t.Run("PartialFailure", func(t *testing.T) {
+			online := newTestNode(map[string]testHandler{
+				"getblockcount": testResult(100),
+			})
+			defer online.Close()
+
+			offline := newTestNode(map[string]testHandler{})
+			defer offline.Close()
+
+			client, err := neo.NewClientUsingMultipleNodes([]string{online.URL, offline.URL})
+			assert.NoError(t, err)
+
+			err = client.Warmup(context.Background())
+
+			batchErr, ok := err.(neo.BatchError)
+			assert.True(t, ok)
+			assert.Len(t, batchErr, 1)
+			assert.Error(t, batchErr[offline.URL])
+			assert.Len(t, online.Calls("getblockcount"), 2)
+		})
+
+		t.Run("CancelledContext", func(t *testing.T) {
+			node := newTestNode(map[string]testHandler{
+				"getblockcount": testResult(100),
+			})
+			defer node.Close()
+
+			client := neo.NewClient(node.URL)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := client.Warmup(ctx)
+			assert.Equal(t, context.Canceled, err)
+			assert.Empty(t, node.Calls("getblockcount"))
+		})
+	})
+}